@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"perf-drizzle/go/bundb"
+	"perf-drizzle/go/db"
+	"perf-drizzle/go/entdb"
+	"perf-drizzle/go/gormdb"
+	"perf-drizzle/go/jetdb"
+	"perf-drizzle/go/memdb"
+	"perf-drizzle/go/rawdb"
+	"perf-drizzle/go/sqlitedb"
+	"perf-drizzle/go/sqlxdb"
+	"perf-drizzle/go/stdlibdb"
+	"slices"
+	"strings"
+)
+
+// backends maps a --backend name to the constructor of its db.Store.
+var backends = map[string]func(databaseUrl string, cfg *Config) (db.Store, error){
+	"sqlc": func(databaseUrl string, cfg *Config) (db.Store, error) {
+		return db.NewDatabase(databaseUrl, cfg.ExecMode, cfg.Pool)
+	},
+	"sqlx": func(databaseUrl string, cfg *Config) (db.Store, error) {
+		return sqlxdb.New(databaseUrl, cfg.SQLPool)
+	},
+	"ent": func(databaseUrl string, cfg *Config) (db.Store, error) {
+		return entdb.New(databaseUrl, cfg.SQLPool)
+	},
+	"gorm": func(databaseUrl string, cfg *Config) (db.Store, error) {
+		return gormdb.New(databaseUrl, cfg.SQLPool)
+	},
+	"bun": func(databaseUrl string, cfg *Config) (db.Store, error) {
+		return bundb.New(databaseUrl, cfg.SQLPool)
+	},
+	"database-sql": func(databaseUrl string, cfg *Config) (db.Store, error) {
+		return stdlibdb.New(stdlibdb.DriverPgx, databaseUrl, cfg.SQLPool)
+	},
+	"jet": func(databaseUrl string, cfg *Config) (db.Store, error) {
+		return jetdb.New(databaseUrl, cfg.SQLPool)
+	},
+	"memory": func(databaseUrl string, cfg *Config) (db.Store, error) {
+		return memdb.New(databaseUrl)
+	},
+	"raw-pgx": func(databaseUrl string, cfg *Config) (db.Store, error) {
+		return rawdb.New(databaseUrl, cfg.Pool)
+	},
+	"sqlite": func(_ string, cfg *Config) (db.Store, error) {
+		return sqlitedb.New(cfg.SQLiteFile, cfg.SQLiteReadConns)
+	},
+	"lib-pq": func(databaseUrl string, cfg *Config) (db.Store, error) {
+		return stdlibdb.New(stdlibdb.DriverPq, databaseUrl, cfg.SQLPool)
+	},
+}
+
+// servesFromPostgres reports whether backend queries Postgres while serving,
+// rather than answering from memory or a local file.
+func servesFromPostgres(backend string) bool {
+	return backend != "memory" && backend != "sqlite"
+}
+
+func backendNames() string {
+	names := make([]string, 0, len(backends))
+	for name := range backends {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return strings.Join(names, ", ")
+}
+
+func newStore(cfg *Config, databaseUrl string) (db.Store, error) {
+	newBackend, ok := backends[cfg.Backend]
+	if !ok {
+		return nil, fmt.Errorf("unknown backend %q, expected one of: %s", cfg.Backend, backendNames())
+	}
+
+	return newBackend(databaseUrl, cfg)
+}
@@ -0,0 +1,306 @@
+// Package bundb implements db.Store with bun, loading relations with
+// Relation() and the order aggregates with ColumnExpr.
+package bundb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"perf-drizzle/go/db"
+	"perf-drizzle/go/stdlibdb"
+
+	"github.com/bytedance/sonic"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+// Bun only joins tables with a primary key, so every model shadows the ID of
+// the sqlc struct it embeds with one tagged pk and copies it back in row().
+type employee struct {
+	bun.BaseModel `bun:"table:employees,alias:e"`
+	ID            int32 `bun:",pk"`
+	db.Employee
+	Recipient *employee `bun:"rel:belongs-to,join:recipient_id=id"`
+}
+
+func (e employee) row() db.EmployeesRow {
+	e.Employee.ID = e.ID
+	return db.EmployeeRow(e.Employee)
+}
+
+type supplier struct {
+	bun.BaseModel `bun:"table:suppliers,alias:s"`
+	ID            int32 `bun:",pk"`
+	db.Supplier
+}
+
+func (s supplier) row() db.Supplier {
+	s.Supplier.ID = s.ID
+	return s.Supplier
+}
+
+type product struct {
+	bun.BaseModel `bun:"table:products,alias:p"`
+	ID            int32 `bun:",pk"`
+	db.Product
+	Supplier *supplier `bun:"rel:belongs-to,join:supplier_id=id"`
+}
+
+func (p product) row() db.ProductsRow {
+	p.Product.ID = p.ID
+	return db.ProductRow(p.Product)
+}
+
+type orderDetail struct {
+	bun.BaseModel `bun:"table:order_details,alias:d"`
+	db.OrderDetail
+	Product *product `bun:"rel:belongs-to,join:product_id=id"`
+}
+
+type order struct {
+	bun.BaseModel `bun:"table:orders,alias:o"`
+	ID            int32 `bun:",pk"`
+	db.Order
+	Details []orderDetail `bun:"rel:has-many,join:id=order_id"`
+}
+
+type Store struct {
+	db *bun.DB
+}
+
+func New(databaseUrl string, pool stdlibdb.PoolConfig) (*Store, error) {
+	sqlDB, err := sql.Open("pgx", databaseUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	pool.Apply(sqlDB)
+
+	return &Store{db: bun.NewDB(sqlDB, pgdialect.New())}, nil
+}
+
+func (s *Store) Ping(ctx context.Context) error {
+	return s.db.PingContext(ctx)
+}
+
+func (s *Store) Close() {
+	s.db.Close()
+}
+
+func (s *Store) Customers(ctx context.Context, arg db.CustomersParams) ([]db.Customer, error) {
+	items := []db.Customer{}
+	err := s.db.NewSelect().Model(&items).Order("id ASC").Limit(int(arg.Limit)).Offset(int(arg.Offset)).Scan(ctx)
+	return items, err
+}
+
+func (s *Store) CustomerById(ctx context.Context, id int32) (db.Customer, error) {
+	var i db.Customer
+	err := s.db.NewSelect().Model(&i).Where("id = ?", id).Scan(ctx)
+	return i, err
+}
+
+func (s *Store) SearchCustomer(ctx context.Context, arg db.SearchParams) ([]db.Customer, error) {
+	items := []db.Customer{}
+	err := search(s.db.NewSelect().Model(&items), "company_name", string(arg.Mode), arg.Query(), arg.Limit, arg.Offset).Scan(ctx)
+	return items, err
+}
+
+func (s *Store) SearchCustomerHeadline(ctx context.Context, arg db.SearchParams) ([]db.SearchCustomerHeadlineRow, error) {
+	mode, query := string(arg.Mode), arg.Query()
+	items := []db.SearchCustomerHeadlineRow{}
+	err := search(s.db.NewSelect().Model((*db.Customer)(nil)), "company_name", mode, query, arg.Limit, arg.Offset).
+		ColumnExpr("*").
+		ColumnExpr(headline("company_name", mode), query).
+		Scan(ctx, &items)
+	return items, err
+}
+
+func (s *Store) Employees(ctx context.Context, arg db.EmployeesParams) ([]db.EmployeesRow, error) {
+	var employees []db.Employee
+	err := s.db.NewSelect().Model(&employees).Order("id ASC").Limit(int(arg.Limit)).Offset(int(arg.Offset)).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.EmployeesRow, len(employees))
+	for i, e := range employees {
+		items[i] = db.EmployeeRow(e)
+	}
+	return items, nil
+}
+
+func (s *Store) EmployeeWithRecipient(ctx context.Context, id int32) ([]db.EmployeeWithRecipientRow, error) {
+	var employees []employee
+	err := s.db.NewSelect().Model(&employees).Relation("Recipient").Where("e.id = ?", id).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.EmployeeWithRecipientRow, len(employees))
+	for i, e := range employees {
+		var recipient db.RawJSON
+		if e.Recipient != nil {
+			if recipient, err = sonic.Marshal(e.Recipient.row()); err != nil {
+				return nil, err
+			}
+		}
+
+		items[i] = e.row().WithRecipient(recipient)
+	}
+	return items, nil
+}
+
+func (s *Store) Suppliers(ctx context.Context, arg db.SuppliersParams) ([]db.Supplier, error) {
+	items := []db.Supplier{}
+	err := s.db.NewSelect().Model(&items).Order("id ASC").Limit(int(arg.Limit)).Offset(int(arg.Offset)).Scan(ctx)
+	return items, err
+}
+
+func (s *Store) SupplierById(ctx context.Context, id int32) (db.Supplier, error) {
+	var i db.Supplier
+	err := s.db.NewSelect().Model(&i).Where("id = ?", id).Scan(ctx)
+	return i, err
+}
+
+func (s *Store) Products(ctx context.Context, arg db.ProductsParams) ([]db.ProductsRow, error) {
+	var products []db.Product
+	err := s.db.NewSelect().Model(&products).Order("id ASC").Limit(int(arg.Limit)).Offset(int(arg.Offset)).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.ProductsRow, len(products))
+	for i, p := range products {
+		items[i] = db.ProductRow(p)
+	}
+	return items, nil
+}
+
+func (s *Store) ProductWithSupplier(ctx context.Context, id int32) ([]db.ProductWithSupplierRow, error) {
+	var products []product
+	err := s.db.NewSelect().Model(&products).Relation("Supplier").Where("p.id = ?", id).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.ProductWithSupplierRow, len(products))
+	for i, p := range products {
+		var supplier db.RawJSON
+		if p.Supplier != nil {
+			if supplier, err = sonic.Marshal(p.Supplier.row()); err != nil {
+				return nil, err
+			}
+		}
+
+		items[i] = p.row().WithSupplier(supplier)
+	}
+	return items, nil
+}
+
+func (s *Store) SearchProduct(ctx context.Context, arg db.SearchParams) ([]db.SearchProductRow, error) {
+	var products []db.Product
+	err := search(s.db.NewSelect().Model(&products), "name", string(arg.Mode), arg.Query(), arg.Limit, arg.Offset).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.SearchProductRow, len(products))
+	for i, p := range products {
+		items[i] = db.SearchProductRow(db.ProductRow(p))
+	}
+	return items, nil
+}
+
+func (s *Store) SearchProductHeadline(ctx context.Context, arg db.SearchParams) ([]db.SearchProductHeadlineRow, error) {
+	mode, query := string(arg.Mode), arg.Query()
+	items := []db.SearchProductHeadlineRow{}
+	err := search(s.db.NewSelect().Model((*db.Product)(nil)), "name", mode, query, arg.Limit, arg.Offset).
+		ColumnExpr("id, name, qt_per_unit AS quantity_per_unit, unit_price, units_in_stock, units_on_order, reorder_level, discontinued, supplier_id").
+		ColumnExpr(headline("name", mode), query).
+		Scan(ctx, &items)
+	return items, err
+}
+
+func (s *Store) OrdersWithDetails(ctx context.Context, arg db.OrdersWithDetailsParams) ([]db.OrdersWithDetailsRow, error) {
+	items := []db.OrdersWithDetailsRow{}
+	err := orderTotals(s.db.NewSelect()).
+		OrderExpr("orders.id ASC").
+		Limit(int(arg.Limit)).
+		Offset(int(arg.Offset)).
+		Scan(ctx, &items)
+	return items, err
+}
+
+func (s *Store) OrderWithDetails(ctx context.Context, id int32) ([]db.OrderWithDetailsRow, error) {
+	items := []db.OrderWithDetailsRow{}
+	err := orderTotals(s.db.NewSelect()).
+		Where("orders.id = ?", id).
+		OrderExpr("orders.id ASC").
+		Scan(ctx, &items)
+	return items, err
+}
+
+func (s *Store) OrderWithDetailsAndProducts(ctx context.Context, id int32) ([]db.OrderWithDetailsAndProductsRow, error) {
+	var orders []order
+	err := s.db.NewSelect().Model(&orders).Relation("Details.Product").Where("o.id = ?", id).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.OrderWithDetailsAndProductsRow, len(orders))
+	for i, o := range orders {
+		details := make([]db.OrderDetailWithProduct, len(o.Details))
+		for j, d := range o.Details {
+			details[j].OrderDetail = d.OrderDetail
+			if d.Product != nil {
+				p := d.Product.row()
+				details[j].Product = &p
+			}
+		}
+
+		raw, err := sonic.Marshal(details)
+		if err != nil {
+			return nil, err
+		}
+
+		o.Order.ID = o.ID
+		items[i] = o.Order.WithDetails(raw)
+	}
+	return items, nil
+}
+
+// search filters, ranks and paginates a full-text query over column, the same
+// way the sqlc search queries do.
+func search(q *bun.SelectQuery, column, mode, query string, limit, offset int32) *bun.SelectQuery {
+	vector := fmt.Sprintf("to_tsvector('english', %s)", column)
+	tsquery := fmt.Sprintf("%s('english', ?)", db.TSQueryFunc(mode))
+
+	return q.
+		Where(vector+" @@ "+tsquery, query).
+		OrderExpr(fmt.Sprintf("ts_rank(%s, %s) DESC", vector, tsquery), query).
+		OrderExpr("id ASC").
+		Limit(int(limit)).
+		Offset(int(offset))
+}
+
+func headline(column, mode string) string {
+	return fmt.Sprintf("ts_headline('english', %s, %s('english', ?))::text AS headline", column, db.TSQueryFunc(mode))
+}
+
+func orderTotals(q *bun.SelectQuery) *bun.SelectQuery {
+	return q.
+		TableExpr("orders").
+		ColumnExpr("orders.id, orders.shipped_date, orders.ship_name, orders.ship_city, orders.ship_country").
+		ColumnExpr("count(order_details.product_id)::int AS products_count").
+		ColumnExpr("sum(order_details.quantity)::int AS quantity_sum").
+		ColumnExpr("sum(order_details.quantity * order_details.unit_price)::real AS total_price").
+		Join("LEFT JOIN order_details ON order_details.order_id = orders.id").
+		GroupExpr("orders.id")
+}
+
+var (
+	_ db.Store       = (*Store)(nil)
+	_ db.Highlighter = (*Store)(nil)
+	_ db.Pinger      = (*Store)(nil)
+)
@@ -0,0 +1,304 @@
+// Command generate writes data/requests.json as src/generate.ts does, but
+// with the mix, the total and the search terms read from a config file, and
+// with a seeded shuffle, so the same config and database give the same file.
+//
+//	DATABASE_URL=postgres://... generate --config bench/generate.json --out data/requests.json
+package main
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"maps"
+	"math"
+	"math/rand/v2"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// maxPageSize is the largest limit the server accepts, see go/params.go.
+const maxPageSize = 500
+
+// config is the JSON file generate reads.
+type config struct {
+	// Seed seeds the pages picked and the shuffle.
+	Seed uint64 `json:"seed"`
+	// Total is the number of requests written.
+	Total int `json:"total"`
+	// Mix maps routes to their share of the total, in any unit.
+	Mix map[string]float64 `json:"mix"`
+	// Limits maps the paginated routes to their page size.
+	Limits           map[string]int `json:"limits"`
+	CustomerSearches []string       `json:"customerSearches"`
+	ProductSearches  []string       `json:"productSearches"`
+}
+
+type kind int
+
+const (
+	byID kind = iota
+	search
+	page
+)
+
+// route is how generate builds the requests of one route: ids and pages come
+// from the table, terms from the config.
+type route struct {
+	kind  kind
+	table string
+}
+
+var routes = map[string]route{
+	"/customer-by-id":                  {byID, "customers"},
+	"/employee-with-recipient":         {byID, "employees"},
+	"/supplier-by-id":                  {byID, "suppliers"},
+	"/product-with-supplier":           {byID, "products"},
+	"/order-with-details":              {byID, "orders"},
+	"/order-with-details-and-products": {byID, "orders"},
+	"/search-customer":                 {search, "customers"},
+	"/search-product":                  {search, "products"},
+	"/customers":                       {page, "customers"},
+	"/employees":                       {page, "employees"},
+	"/suppliers":                       {page, "suppliers"},
+	"/products":                        {page, "products"},
+	"/orders-with-details":             {page, "orders"},
+}
+
+// ids is the id range and the row count of a table.
+type ids struct {
+	min, max, count int
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("generate: ")
+
+	configPath := flag.String("config", "bench/generate.json", "JSON file with the seed, the total, the mix, the page sizes and the search terms")
+	out := flag.String("out", "data/requests.json", "JSON array of request paths to write")
+	seed := flag.Uint64("seed", 0, "seed to use instead of the config's")
+	flag.Parse()
+
+	cfg, err := loadConfig(*configPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "seed" {
+			cfg.Seed = *seed
+		}
+	})
+
+	databaseUrl := os.Getenv("DATABASE_URL")
+	if databaseUrl == "" {
+		log.Fatal("DATABASE_URL is not set")
+	}
+
+	tables, err := loadIDs(context.Background(), databaseUrl, cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	requests := generate(cfg, tables)
+	if err := write(*out, requests); err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("%d shuffled requests with seed %d", len(requests), cfg.Seed)
+}
+
+func loadConfig(path string) (*config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return &cfg, nil
+}
+
+func (c *config) validate() error {
+	var errs []error
+	if c.Total < 1 {
+		errs = append(errs, errors.New("total must be at least 1"))
+	}
+
+	var sum float64
+	for path, share := range c.Mix {
+		r, ok := routes[path]
+		switch {
+		case !ok:
+			errs = append(errs, fmt.Errorf("unknown route %q in mix", path))
+			continue
+		case share < 0:
+			errs = append(errs, fmt.Errorf("mix of %s is negative", path))
+		case share > 0 && r.kind == page && c.Limits[path] == 0:
+			errs = append(errs, fmt.Errorf("limits has no page size for %s", path))
+		case share > 0 && r.kind == search && len(c.terms(path)) == 0:
+			errs = append(errs, fmt.Errorf("no search terms for %s", path))
+		}
+		sum += share
+	}
+	if sum <= 0 {
+		errs = append(errs, errors.New("mix has no route with a share above 0"))
+	}
+
+	for path, limit := range c.Limits {
+		if routes[path].kind != page {
+			errs = append(errs, fmt.Errorf("limits has a page size for %q, which is not paginated", path))
+		} else if limit < 1 || limit > maxPageSize {
+			errs = append(errs, fmt.Errorf("page size of %s must be between 1 and %d, got %d", path, maxPageSize, limit))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *config) terms(path string) []string {
+	if path == "/search-customer" {
+		return c.CustomerSearches
+	}
+	return c.ProductSearches
+}
+
+// counts splits the total across the mix by largest remainder, so the counts
+// add up to it exactly. Routes are taken in sorted order, which keeps ties
+// and everything after reproducible.
+func (c *config) counts() ([]string, map[string]int) {
+	paths := slices.Sorted(maps.Keys(c.Mix))
+
+	var sum float64
+	for _, path := range paths {
+		sum += c.Mix[path]
+	}
+
+	counts := map[string]int{}
+	remainders := map[string]float64{}
+	left := c.Total
+	for _, path := range paths {
+		exact := float64(c.Total) * c.Mix[path] / sum
+		counts[path] = int(math.Floor(exact))
+		remainders[path] = exact - math.Floor(exact)
+		left -= counts[path]
+	}
+
+	byRemainder := slices.Clone(paths)
+	slices.SortStableFunc(byRemainder, func(a, b string) int {
+		return cmp.Compare(remainders[b], remainders[a])
+	})
+	for _, path := range byRemainder[:left] {
+		counts[path]++
+	}
+
+	return paths, counts
+}
+
+// loadIDs reads the id range and row count of every table the mix needs.
+func loadIDs(ctx context.Context, databaseUrl string, cfg *config) (map[string]ids, error) {
+	conn, err := pgx.Connect(ctx, databaseUrl)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close(context.Background())
+
+	tables := map[string]ids{}
+	for path, share := range cfg.Mix {
+		table := routes[path].table
+		if share == 0 || routes[path].kind == search {
+			continue
+		}
+		if _, ok := tables[table]; ok {
+			continue
+		}
+
+		// table comes from routes, never from the config.
+		var t ids
+		err := conn.QueryRow(ctx, `select coalesce(min(id), 0)::int, coalesce(max(id), 0)::int, count(*)::int from `+pgx.Identifier{table}.Sanitize()).Scan(&t.min, &t.max, &t.count)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", table, err)
+		}
+		if t.count == 0 {
+			return nil, fmt.Errorf("%s is empty, run pnpm start:seed first", table)
+		}
+		tables[table] = t
+	}
+
+	return tables, nil
+}
+
+// generate cycles through the ids and the search terms as src/generate.ts
+// does, picks pages at random, and shuffles the lot once.
+func generate(cfg *config, tables map[string]ids) []string {
+	rng := rand.New(rand.NewPCG(cfg.Seed, cfg.Seed))
+
+	paths, counts := cfg.counts()
+	requests := make([]string, 0, cfg.Total)
+	for _, path := range paths {
+		r := routes[path]
+		t := tables[r.table]
+
+		for i := range counts[path] {
+			switch r.kind {
+			case byID:
+				id := t.min + i%(t.max-t.min+1)
+				requests = append(requests, path+"?id="+strconv.Itoa(id))
+			case search:
+				terms := cfg.terms(path)
+				requests = append(requests, path+"?term="+url.QueryEscape(terms[i%len(terms)]))
+			case page:
+				limit := cfg.Limits[path]
+				pages := (t.count + limit - 1) / limit
+				offset := rng.IntN(pages) * limit
+				requests = append(requests, fmt.Sprintf("%s?limit=%d&offset=%d", path, limit, offset))
+			}
+		}
+	}
+
+	rng.Shuffle(len(requests), func(i, j int) {
+		requests[i], requests[j] = requests[j], requests[i]
+	})
+
+	return requests
+}
+
+// write writes the requests as JSON.stringify does, without escaping the &
+// of the paginated routes.
+func write(path string, requests []string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	buf := bufio.NewWriter(f)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(requests); err != nil {
+		return err
+	}
+	if err := buf.Flush(); err != nil {
+		return err
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// tables stands in for a seeded database.
+var tables = map[string]ids{
+	"customers": {min: 1, max: 91, count: 91},
+	"employees": {min: 1, max: 9, count: 9},
+	"suppliers": {min: 1, max: 29, count: 29},
+	"products":  {min: 1, max: 77, count: 77},
+	"orders":    {min: 10248, max: 11077, count: 830},
+}
+
+func testConfig(t *testing.T) *config {
+	t.Helper()
+
+	cfg, err := loadConfig("../../../bench/generate.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cfg
+}
+
+func TestCounts(t *testing.T) {
+	tests := []struct {
+		name  string
+		total int
+		mix   map[string]float64
+		want  map[string]int
+	}{
+		{"exact", 10, map[string]float64{"/customers": 1, "/products": 4}, map[string]int{"/customers": 2, "/products": 8}},
+		{"largest remainder", 10, map[string]float64{"/customers": 1, "/products": 1, "/suppliers": 1}, map[string]int{"/customers": 4, "/products": 3, "/suppliers": 3}},
+		{"fractions", 7, map[string]float64{"/customers": 0.5, "/products": 0.25, "/suppliers": 0.25}, map[string]int{"/customers": 3, "/products": 2, "/suppliers": 2}},
+		{"zero share", 5, map[string]float64{"/customers": 1, "/products": 0}, map[string]int{"/customers": 5, "/products": 0}},
+		{"fewer than routes", 1, map[string]float64{"/customers": 1, "/products": 1}, map[string]int{"/customers": 1, "/products": 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config{Total: tt.total, Mix: tt.mix}
+			_, got := cfg.counts()
+			for path, want := range tt.want {
+				if got[path] != want {
+					t.Errorf("%s: %d requests, want %d", path, got[path], want)
+				}
+			}
+		})
+	}
+}
+
+func TestCountsAddUp(t *testing.T) {
+	cfg := testConfig(t)
+	for _, total := range []int{1, 7, 13, 1000, 99_999, cfg.Total} {
+		cfg.Total = total
+
+		_, counts := cfg.counts()
+		sum := 0
+		for _, n := range counts {
+			sum += n
+		}
+		if sum != total {
+			t.Errorf("counts for %d add up to %d", total, sum)
+		}
+	}
+}
+
+func TestGenerateReproducible(t *testing.T) {
+	cfg := testConfig(t)
+	cfg.Total = 20_000
+
+	first := generate(cfg, tables)
+	if len(first) != cfg.Total {
+		t.Fatalf("generated %d requests, want %d", len(first), cfg.Total)
+	}
+
+	if again := generate(cfg, tables); !slices.Equal(first, again) {
+		t.Error("the same seed generated different requests")
+	}
+
+	// The file is byte for byte the same too.
+	dir := t.TempDir()
+	a, b := filepath.Join(dir, "a", "requests.json"), filepath.Join(dir, "b", "requests.json")
+	if err := write(a, first); err != nil {
+		t.Fatal(err)
+	}
+	if err := write(b, generate(cfg, tables)); err != nil {
+		t.Fatal(err)
+	}
+	dataA, _ := os.ReadFile(a)
+	dataB, _ := os.ReadFile(b)
+	if string(dataA) != string(dataB) {
+		t.Error("the same seed wrote different files")
+	}
+	if strings.Contains(string(dataA), `\u0026`) {
+		t.Error("the file escapes & as JSON.stringify does not")
+	}
+
+	cfg.Seed++
+	if other := generate(cfg, tables); slices.Equal(first, other) {
+		t.Error("another seed generated the same requests")
+	}
+}
+
+func TestGenerateRequests(t *testing.T) {
+	cfg := &config{
+		Seed:  7,
+		Total: 600,
+		Mix: map[string]float64{
+			"/order-with-details":  1,
+			"/search-customer":     1,
+			"/orders-with-details": 1,
+		},
+		Limits:           map[string]int{"/orders-with-details": 50},
+		CustomerSearches: []string{"ve", "chai tea", "a&b", "100%", "#1"},
+	}
+
+	counts := map[string]int{}
+	terms := map[string]bool{}
+	for _, r := range generate(cfg, tables) {
+		u, err := url.Parse(r)
+		if err != nil {
+			t.Fatalf("%s: %v", r, err)
+		}
+		counts[u.Path]++
+		q := u.Query()
+
+		switch u.Path {
+		case "/order-with-details":
+			if id, err := strconv.Atoi(q.Get("id")); err != nil || id < 10248 || id > 11077 {
+				t.Errorf("%s: id outside the orders", r)
+			}
+		case "/search-customer":
+			if len(q) != 1 {
+				t.Errorf("%s: the term leaks into other parameters", r)
+			}
+			terms[q.Get("term")] = true
+		case "/orders-with-details":
+			if q.Get("limit") != "50" {
+				t.Errorf("%s: limit is not the configured page size", r)
+			}
+			// 830 orders make 17 pages of 50.
+			if offset, err := strconv.Atoi(q.Get("offset")); err != nil || offset < 0 || offset > 800 || offset%50 != 0 {
+				t.Errorf("%s: offset is not the start of a page", r)
+			}
+		default:
+			t.Errorf("%s: route not in the mix", r)
+		}
+	}
+
+	for path, n := range counts {
+		if n != 200 {
+			t.Errorf("%s: %d requests, want 200", path, n)
+		}
+	}
+	for _, term := range cfg.CustomerSearches {
+		if !terms[term] {
+			t.Errorf("term %q did not survive the query string", term)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := func() *config {
+		return &config{
+			Total:           10,
+			Mix:             map[string]float64{"/customers": 1, "/search-product": 1},
+			Limits:          map[string]int{"/customers": 50},
+			ProductSearches: []string{"ha"},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		change func(c *config)
+		want   string
+	}{
+		{"valid", func(c *config) {}, ""},
+		{"max page size", func(c *config) { c.Limits["/customers"] = maxPageSize }, ""},
+		{"page size above the server's", func(c *config) { c.Limits["/customers"] = maxPageSize + 1 }, "between 1 and 500"},
+		{"negative page size", func(c *config) { c.Limits["/customers"] = -1 }, "between 1 and 500"},
+		{"missing page size", func(c *config) { delete(c.Limits, "/customers") }, "no page size"},
+		{"page size of a route without pages", func(c *config) { c.Limits["/customer-by-id"] = 10 }, "not paginated"},
+		{"no total", func(c *config) { c.Total = 0 }, "total"},
+		{"unknown route", func(c *config) { c.Mix["/nope"] = 1 }, "unknown route"},
+		{"negative share", func(c *config) { c.Mix["/customers"] = -1 }, "negative"},
+		{"no shares", func(c *config) { c.Mix = map[string]float64{"/customers": 0} }, "no route"},
+		{"no terms", func(c *config) { c.ProductSearches = nil }, "no search terms"},
+		{"no terms for a route left out", func(c *config) { c.Mix["/search-customer"] = 0 }, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := valid()
+			tt.change(c)
+
+			err := c.validate()
+			switch {
+			case tt.want == "" && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			case tt.want != "" && (err == nil || !strings.Contains(err.Error(), tt.want)):
+				t.Errorf("error = %v, want one about %q", err, tt.want)
+			}
+		})
+	}
+}
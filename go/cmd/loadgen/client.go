@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+// result is what the load generator records of one request, or of an arrival
+// the open model dropped, which start dates.
+type result struct {
+	start    time.Time
+	duration time.Duration
+	status   int
+	path     string
+	err      error
+	dropped  bool
+}
+
+// failed matches k6's http_req_failed, which expects statuses below 400.
+func (r result) failed() bool {
+	return r.err != nil || r.status < 200 || r.status >= 400
+}
+
+// route is the path without its query, which is how the servers route.
+func (r result) route() string {
+	route, _, _ := strings.Cut(r.path, "?")
+	return route
+}
+
+// client sends GET requests to one server over keep-alive connections.
+type client struct {
+	hc      *fasthttp.HostClient
+	base    string
+	timeout time.Duration
+}
+
+func newClient(host string, maxConns int, timeout time.Duration) (*client, error) {
+	u, err := url.Parse(host)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return nil, fmt.Errorf("host %q is not an http or https URL", host)
+	}
+
+	addr := u.Host
+	switch {
+	case u.Port() != "":
+	case u.Scheme == "https":
+		addr += ":443"
+	default:
+		addr += ":80"
+	}
+
+	return &client{
+		hc: &fasthttp.HostClient{
+			Addr:     addr,
+			IsTLS:    u.Scheme == "https",
+			MaxConns: maxConns,
+		},
+		base:    strings.TrimSuffix(host, "/"),
+		timeout: timeout,
+	}, nil
+}
+
+// get sends a GET request for path and measures it from start, the time it
+// was due, so that a request the generator sends late is timed from when it
+// should have gone out instead of hiding the wait.
+func (c *client) get(path string, start time.Time) result {
+	req := fasthttp.AcquireRequest()
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseRequest(req)
+	defer fasthttp.ReleaseResponse(resp)
+
+	req.SetRequestURI(c.base + path)
+
+	r := result{start: start, path: path}
+	r.err = c.hc.DoTimeout(req, resp, c.timeout)
+	r.duration = time.Since(r.start)
+	if r.err == nil {
+		r.status = resp.StatusCode()
+	}
+
+	return r
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// closedModel runs virtual users that each send one request, wait for the
+// answer, and sleep before the next, so a slow server also slows the load.
+type closedModel struct {
+	stages stages
+	think  time.Duration
+}
+
+func runClosed(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("loadgen closed", flag.ExitOnError)
+
+	var opts options
+	opts.register(fs)
+
+	m := closedModel{stages: benchStages()}
+	fs.Var(&m.stages, "stages", "duration:target VU ramp, as in k6 (default bench/bench.js's 200 to 3000)")
+	fs.DurationVar(&m.think, "think", 100*time.Millisecond, "think time step: iteration i sleeps step * (i % 6), as bench/bench.js does")
+	fs.Parse(args)
+
+	run, rec, err := opts.start(m.stages.max())
+	if err != nil {
+		return err
+	}
+
+	m.run(ctx, run)
+
+	return rec.finish()
+}
+
+func (m closedModel) run(ctx context.Context, run *run) {
+	var (
+		target     atomic.Int64
+		iterations atomic.Int64
+		wg         sync.WaitGroup
+		mu         sync.Mutex
+		active     = make([]bool, m.stages.max())
+	)
+
+	stop := make(chan struct{})
+
+	// vu keeps iterating while its index is below the target and gives its
+	// slot back when the target drops under it.
+	vu := func(i int) {
+		defer wg.Done()
+
+		for int64(i) < target.Load() {
+			n := iterations.Add(1) - 1
+			run.record(run.client.get(run.requests[n%int64(len(run.requests))], time.Now()))
+
+			select {
+			case <-stop:
+				return
+			case <-time.After(m.think * time.Duration(n%6)):
+			}
+		}
+
+		mu.Lock()
+		active[i] = false
+		mu.Unlock()
+	}
+
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+
+	start := time.Now()
+	for {
+		vus, ok := m.stages.at(time.Since(start))
+		if !ok || ctx.Err() != nil {
+			break
+		}
+
+		target.Store(int64(vus))
+
+		mu.Lock()
+		for i := range int(vus) {
+			if !active[i] {
+				active[i] = true
+				wg.Add(1)
+				go vu(i)
+			}
+		}
+		mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+		case <-ticker.C:
+		}
+	}
+
+	// Like k6, let the iterations under way finish, but skip their sleep.
+	target.Store(0)
+	close(stop)
+	log.Printf("waiting for %d iterations to finish", countActive(&mu, active))
+	wg.Wait()
+}
+
+func countActive(mu *sync.Mutex, active []bool) int {
+	mu.Lock()
+	defer mu.Unlock()
+
+	n := 0
+	for _, a := range active {
+		if a {
+			n++
+		}
+	}
+	return n
+}
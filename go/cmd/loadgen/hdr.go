@@ -0,0 +1,151 @@
+package main
+
+import (
+	"bufio"
+	"maps"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/HdrHistogram/hdrhistogram-go"
+)
+
+// The histograms track latencies in nanoseconds from 1µs to a minute, to 3
+// significant digits, in the unit the HdrHistogram log reports maxima in.
+const (
+	lowestLatency     = int64(time.Microsecond)
+	highestLatency    = int64(time.Minute)
+	significantDigits = 3
+)
+
+// flushLag is how many seconds a bucket stays open after the latest one, for
+// the requests that complete out of order on the way to the recorder.
+const flushLag = 2
+
+func newHistogram() *hdrhistogram.Histogram {
+	return hdrhistogram.New(lowestLatency, highestLatency, significantDigits)
+}
+
+// histograms keeps a latency histogram per route and per second of completion
+// and writes them in the HdrHistogram log format, one interval line tagged
+// with the route each, which loadgen merge combines across runs and machines.
+// The interval timestamps are absolute, which a BaseTime of 0 tells readers,
+// since they would otherwise guess from how far they are from the StartTime.
+type histograms struct {
+	f   *os.File
+	buf *bufio.Writer
+	log *hdrhistogram.HistogramLogWriter
+
+	// expectedInterval, when set, corrects for coordinated omission: a
+	// request that took longer also stands for the ones it held back.
+	expectedInterval time.Duration
+
+	seconds map[int64]map[string]*hdrhistogram.Histogram
+	latest  int64
+}
+
+func newHistograms(path string, expectedInterval time.Duration) (*histograms, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bufio.NewWriter(f)
+	h := &histograms{
+		f:                f,
+		buf:              buf,
+		log:              hdrhistogram.NewHistogramLogWriter(buf),
+		expectedInterval: expectedInterval,
+		seconds:          map[int64]map[string]*hdrhistogram.Histogram{},
+	}
+
+	if err := h.log.OutputLogFormatVersion(); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if err := h.log.OutputStartTime(time.Now().UnixMilli()); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if err := h.log.OutputBaseTime(0); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if err := h.log.OutputLegend(); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// record adds the latency of a successful request, leaving failures out as
+// bench/prepare.ts does.
+func (h *histograms) record(res result) error {
+	if res.failed() {
+		return nil
+	}
+
+	sec := res.start.Add(res.duration).Unix()
+	routes := h.seconds[sec]
+	if routes == nil {
+		routes = map[string]*hdrhistogram.Histogram{}
+		h.seconds[sec] = routes
+	}
+
+	route := res.route()
+	hist := routes[route]
+	if hist == nil {
+		hist = newHistogram()
+		hist.SetTag(route)
+		hist.SetStartTimeMs(sec * 1000)
+		hist.SetEndTimeMs((sec + 1) * 1000)
+		routes[route] = hist
+	}
+
+	v := min(int64(res.duration), highestLatency)
+	if h.expectedInterval > 0 {
+		hist.RecordCorrectedValue(v, int64(h.expectedInterval))
+	} else {
+		hist.RecordValue(v)
+	}
+
+	if sec > h.latest {
+		h.latest = sec
+		return h.flush(sec - flushLag)
+	}
+
+	return nil
+}
+
+// flush writes out the seconds before the given one, oldest first.
+func (h *histograms) flush(before int64) error {
+	for _, sec := range slices.Sorted(maps.Keys(h.seconds)) {
+		if sec >= before {
+			break
+		}
+
+		routes := h.seconds[sec]
+		for _, route := range slices.Sorted(maps.Keys(routes)) {
+			if err := h.log.OutputIntervalHistogram(routes[route]); err != nil {
+				return err
+			}
+		}
+		delete(h.seconds, sec)
+	}
+
+	return nil
+}
+
+func (h *histograms) close() error {
+	defer h.f.Close()
+
+	if err := h.flush(h.latest + 1); err != nil {
+		return err
+	}
+	if err := h.buf.Flush(); err != nil {
+		return err
+	}
+
+	return h.f.Close()
+}
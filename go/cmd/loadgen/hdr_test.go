@@ -0,0 +1,181 @@
+package main
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/HdrHistogram/hdrhistogram-go"
+)
+
+// base is a whole second, so the buckets below start on it.
+var base = time.Unix(1_700_000_000, 0)
+
+// ok is a successful request to path that completes at base+at.
+func ok(path string, at, duration time.Duration) result {
+	return result{start: base.Add(at - duration), duration: duration, status: 200, path: path}
+}
+
+// writeHDR records results into a new HdrHistogram log and returns its path.
+func writeHDR(t *testing.T, expectedInterval time.Duration, results ...result) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "run.hlog")
+	h, err := newHistograms(path, expectedInterval)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, res := range results {
+		if err := h.record(res); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := h.close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func readHDR(t *testing.T, paths ...string) map[interval]*hdrhistogram.Histogram {
+	t.Helper()
+
+	merged := map[interval]*hdrhistogram.Histogram{}
+	for _, path := range paths {
+		if err := readLog(path, merged); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return merged
+}
+
+func TestHistogramsRoundTrip(t *testing.T) {
+	sec := base.Unix()
+	path := writeHDR(t, 0,
+		ok("/customers?limit=50&offset=0", 100*time.Millisecond, 2*time.Millisecond),
+		ok("/customers?limit=50&offset=50", 900*time.Millisecond, 4*time.Millisecond),
+		ok("/customer-by-id?id=1", 500*time.Millisecond, time.Millisecond),
+		// Completes in the next second, although it started in the first.
+		ok("/customer-by-id?id=2", 1100*time.Millisecond, 300*time.Millisecond),
+		// Failures and dropped arrivals are left out.
+		result{start: base, duration: time.Millisecond, status: 500, path: "/customers"},
+		result{start: base, duration: time.Millisecond, err: errors.New("timeout"), path: "/customers"},
+		result{start: base, dropped: true, path: "/customers"},
+		// Far enough ahead to flush the seconds before it while recording.
+		ok("/products", 5*time.Second, time.Minute+time.Second),
+	)
+
+	merged := readHDR(t, path)
+
+	tests := []struct {
+		interval interval
+		count    int64
+		max      time.Duration
+	}{
+		{interval{sec, "/customers"}, 2, 4 * time.Millisecond},
+		{interval{sec, "/customer-by-id"}, 1, time.Millisecond},
+		{interval{sec + 1, "/customer-by-id"}, 1, 300 * time.Millisecond},
+		// Latencies above the range are clamped to its top.
+		{interval{sec + 5, "/products"}, 1, time.Minute},
+	}
+
+	if len(merged) != len(tests) {
+		t.Errorf("read %d intervals, want %d: %v", len(merged), len(tests), merged)
+	}
+
+	for _, tt := range tests {
+		h := merged[tt.interval]
+		if h == nil {
+			t.Errorf("no histogram for %+v", tt.interval)
+			continue
+		}
+
+		if h.TotalCount() != tt.count {
+			t.Errorf("%+v: count %d, want %d", tt.interval, h.TotalCount(), tt.count)
+		}
+		if !h.ValuesAreEquivalent(h.ValueAtQuantile(100), int64(tt.max)) {
+			t.Errorf("%+v: max %s, want %s", tt.interval, time.Duration(h.ValueAtQuantile(100)), tt.max)
+		}
+		if h.Tag() != tt.interval.route {
+			t.Errorf("%+v: tag %q", tt.interval, h.Tag())
+		}
+		if h.StartTimeMs() != tt.interval.sec*1000 || h.EndTimeMs() != (tt.interval.sec+1)*1000 {
+			t.Errorf("%+v: spans %d to %d ms, want absolute timestamps", tt.interval, h.StartTimeMs(), h.EndTimeMs())
+		}
+	}
+}
+
+func TestHistogramsMerge(t *testing.T) {
+	sec := base.Unix()
+	a := writeHDR(t, 0,
+		ok("/customers", 100*time.Millisecond, time.Millisecond),
+		ok("/customers", 200*time.Millisecond, 2*time.Millisecond),
+		ok("/products", 1500*time.Millisecond, time.Millisecond),
+	)
+	b := writeHDR(t, 0,
+		ok("/customers", 700*time.Millisecond, 8*time.Millisecond),
+		ok("/suppliers", 1500*time.Millisecond, time.Millisecond),
+	)
+
+	merged := readHDR(t, a, b)
+
+	want := map[interval]int64{
+		{sec, "/customers"}:     3,
+		{sec + 1, "/products"}:  1,
+		{sec + 1, "/suppliers"}: 1,
+	}
+	if len(merged) != len(want) {
+		t.Errorf("merged %d intervals, want %d", len(merged), len(want))
+	}
+	for i, count := range want {
+		if h := merged[i]; h == nil || h.TotalCount() != count {
+			t.Errorf("%+v: got %v, want a count of %d", i, h, count)
+		}
+	}
+
+	customers := merged[interval{sec, "/customers"}]
+	if !customers.ValuesAreEquivalent(customers.Max(), int64(8*time.Millisecond)) {
+		t.Errorf("merged max %s, want 8ms", time.Duration(customers.Max()))
+	}
+
+	// Writing the merged histograms out and reading them back changes nothing.
+	out := filepath.Join(t.TempDir(), "merged.hlog")
+	intervals := []interval{{sec, "/customers"}, {sec + 1, "/products"}, {sec + 1, "/suppliers"}}
+	if err := writeLog(out, intervals, merged); err != nil {
+		t.Fatal(err)
+	}
+
+	again := readHDR(t, out)
+	for i, count := range want {
+		if h := again[i]; h == nil || h.TotalCount() != count {
+			t.Errorf("rewritten %+v: got %v, want a count of %d", i, h, count)
+		}
+	}
+}
+
+func TestHistogramsExpectedInterval(t *testing.T) {
+	sec := base.Unix()
+	tests := []struct {
+		name             string
+		expectedInterval time.Duration
+		count            int64
+	}{
+		{"as measured", 0, 1},
+		// A 50ms request expected every 10ms also stands for the four
+		// requests it held back, at 40, 30, 20 and 10ms.
+		{"corrected", 10 * time.Millisecond, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged := readHDR(t, writeHDR(t, tt.expectedInterval, ok("/customers", 500*time.Millisecond, 50*time.Millisecond)))
+
+			h := merged[interval{sec, "/customers"}]
+			if h == nil || h.TotalCount() != tt.count {
+				t.Fatalf("got %v, want a count of %d", h, tt.count)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+// Command loadgen replays data/requests.json against one of the servers and
+// writes every request as the rows k6's CSV output has, so bench/index.ts and
+// bench/prepare.ts read its results as they read bench/bench.js's.
+//
+//	loadgen [closed] --host http://localhost:3002 --out results/go.csv
+//	loadgen open --host http://localhost:3002 --out results/go.csv --stages 10s:1000,50s:1000
+//	loadgen saturate --host http://localhost:3002 --target 'p99<50ms,errors<0.1%' --curve results/go-curve.csv
+//	loadgen merge --csv results/go-latency.csv a.hlog b.hlog
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"maps"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// commands maps the first argument to a load model, to the search for the
+// highest sustainable rate, or to merge. Without one, loadgen runs the closed
+// model of bench/bench.js.
+var commands = map[string]func(ctx context.Context, args []string) error{
+	"closed":   runClosed,
+	"open":     runOpen,
+	"saturate": runSaturate,
+	"merge":    runMerge,
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("loadgen: ")
+
+	name, args := "closed", os.Args[1:]
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		name, args = args[0], args[1:]
+	}
+
+	command, ok := commands[name]
+	if !ok {
+		log.Fatalf("unknown command %q, expected one of: %s", name, strings.Join(slices.Sorted(maps.Keys(commands)), ", "))
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := command(ctx, args); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// target is the server and the requests every command sends it.
+type target struct {
+	host     string
+	requests string
+	search   bool
+	timeout  time.Duration
+}
+
+func (t *target) register(fs *flag.FlagSet) {
+	fs.StringVar(&t.host, "host", "", "base URL of the server, such as http://localhost:3002")
+	fs.StringVar(&t.requests, "requests", "data/requests.json", "JSON array of request paths, written by src/generate.ts")
+	fs.BoolVar(&t.search, "search", false, "keep the /search requests, which bench/bench.js filters out")
+	fs.DurationVar(&t.timeout, "timeout", 30*time.Second, "request timeout")
+}
+
+// sink takes the result of every request of a run, from any goroutine.
+type sink interface {
+	record(res result)
+}
+
+// run holds what a load model needs while it runs.
+type run struct {
+	requests []string
+	client   *client
+	sink     sink
+}
+
+func (r *run) record(res result) {
+	r.sink.record(res)
+}
+
+// start loads the requests for a run of up to maxConns requests at a time.
+func (t *target) start(maxConns int, sink sink) (*run, error) {
+	if t.host == "" {
+		return nil, errors.New("host is required")
+	}
+
+	requests, err := loadRequests(t.requests, t.search)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := newClient(t.host, max(maxConns, 1), t.timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &run{requests: requests, client: c, sink: sink}, nil
+}
+
+// options are the flags of the load models that write out every request.
+type options struct {
+	target
+	out string
+
+	hdr              string
+	expectedInterval time.Duration
+}
+
+func (o *options) register(fs *flag.FlagSet) {
+	o.target.register(fs)
+	fs.StringVar(&o.out, "out", "", "CSV file to write the results to")
+	fs.StringVar(&o.hdr, "hdr", "", "HdrHistogram log to write latencies to, per route and per second, for loadgen merge")
+	fs.DurationVar(&o.expectedInterval, "expected-interval", 0, "interval between requests of one sender, to correct the histograms for coordinated omission; 0 records latencies as measured")
+}
+
+// start checks the options, loads the requests and opens the outputs for a
+// run of up to maxConns requests at a time.
+func (o *options) start(maxConns int) (*run, *recorder, error) {
+	if o.host == "" {
+		return nil, nil, errors.New("host is required")
+	}
+	if o.out == "" {
+		return nil, nil, errors.New("out is required")
+	}
+
+	var hdr *histograms
+	if o.hdr != "" {
+		var err error
+		if hdr, err = newHistograms(o.hdr, o.expectedInterval); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	r, err := newRecorder(o.out, strings.TrimSuffix(o.host, "/"), hdr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	run, err := o.target.start(maxConns, r)
+	if err != nil {
+		r.close()
+		return nil, nil, err
+	}
+
+	return run, r, nil
+}
+
+func loadRequests(path string, search bool) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var requests []string
+	if err := json.Unmarshal(data, &requests); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	if !search {
+		requests = slices.DeleteFunc(requests, func(r string) bool {
+			return strings.HasPrefix(r, "/search")
+		})
+	}
+	if len(requests) == 0 {
+		return nil, fmt.Errorf("%s: no requests to send", path)
+	}
+
+	return requests, nil
+}
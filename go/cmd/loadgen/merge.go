@@ -0,0 +1,187 @@
+package main
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/HdrHistogram/hdrhistogram-go"
+)
+
+// percentiles are the ones bench/prepare.ts reports, and the median.
+var percentiles = []float64{50, 90, 95, 99}
+
+// interval identifies the histograms merge adds up: one route in one second.
+type interval struct {
+	sec   int64
+	route string
+}
+
+// runMerge adds up the --hdr logs of one or more runs, such as the ones of
+// several generator machines loading the same server, prints the latency
+// percentiles of every route, and optionally writes them per second.
+func runMerge(_ context.Context, args []string) error {
+	fs := flag.NewFlagSet("loadgen merge", flag.ExitOnError)
+	out := fs.String("out", "", "HdrHistogram log to write the merged histograms to")
+	csvOut := fs.String("csv", "", "CSV file to write the percentiles of every route and second to, in milliseconds")
+	fs.Parse(args)
+
+	if fs.NArg() == 0 {
+		return errors.New("no logs to merge")
+	}
+
+	merged := map[interval]*hdrhistogram.Histogram{}
+	for _, path := range fs.Args() {
+		if err := readLog(path, merged); err != nil {
+			return err
+		}
+	}
+
+	intervals := slices.SortedFunc(maps.Keys(merged), func(a, b interval) int {
+		return cmp.Or(cmp.Compare(a.sec, b.sec), cmp.Compare(a.route, b.route))
+	})
+
+	if *out != "" {
+		if err := writeLog(*out, intervals, merged); err != nil {
+			return err
+		}
+	}
+	if *csvOut != "" {
+		if err := writePercentiles(*csvOut, intervals, merged); err != nil {
+			return err
+		}
+	}
+
+	totals := map[string]*hdrhistogram.Histogram{"all": newHistogram()}
+	for _, i := range intervals {
+		if totals[i.route] == nil {
+			totals[i.route] = newHistogram()
+		}
+		totals[i.route].Merge(merged[i])
+		totals["all"].Merge(merged[i])
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprint(w, "route\tcount\tp50\tp90\tp95\tp99\tmax\t\n")
+	for _, route := range slices.Sorted(maps.Keys(totals)) {
+		h := totals[route]
+		fmt.Fprintf(w, "%s\t%d\t", route, h.TotalCount())
+		for _, p := range percentiles {
+			fmt.Fprintf(w, "%s\t", time.Duration(h.ValueAtPercentile(p)))
+		}
+		fmt.Fprintf(w, "%s\t\n", time.Duration(h.Max()))
+	}
+
+	return w.Flush()
+}
+
+func readLog(path string, merged map[interval]*hdrhistogram.Histogram) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := hdrhistogram.NewHistogramLogReader(f)
+	for {
+		h, err := r.NextIntervalHistogram()
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		if h == nil {
+			return nil
+		}
+
+		i := interval{sec: h.StartTimeMs() / 1000, route: h.Tag()}
+		if merged[i] == nil {
+			merged[i] = newHistogram()
+			merged[i].SetTag(i.route)
+			merged[i].SetStartTimeMs(i.sec * 1000)
+			merged[i].SetEndTimeMs((i.sec + 1) * 1000)
+		}
+		if dropped := merged[i].Merge(h); dropped > 0 {
+			return fmt.Errorf("%s: %d values out of range", path, dropped)
+		}
+	}
+}
+
+func writeLog(path string, intervals []interval, merged map[interval]*hdrhistogram.Histogram) error {
+	return writeFile(path, func(w io.Writer) error {
+		log := hdrhistogram.NewHistogramLogWriter(w)
+		if err := log.OutputLogFormatVersion(); err != nil {
+			return err
+		}
+		if len(intervals) > 0 {
+			if err := log.OutputStartTime(intervals[0].sec * 1000); err != nil {
+				return err
+			}
+		}
+		if err := log.OutputBaseTime(0); err != nil {
+			return err
+		}
+		if err := log.OutputLegend(); err != nil {
+			return err
+		}
+
+		for _, i := range intervals {
+			if err := log.OutputIntervalHistogram(merged[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// writePercentiles writes what bench/prepare.ts computes with percentile_cont
+// for every second, split by route as well.
+func writePercentiles(path string, intervals []interval, merged map[interval]*hdrhistogram.Histogram) error {
+	return writeFile(path, func(w io.Writer) error {
+		cw := csv.NewWriter(w)
+		cw.Write([]string{"timestamp", "route", "count", "latency_50", "latency_90", "latency_95", "latency_99", "latency_max"})
+
+		ms := func(v int64) string {
+			return strconv.FormatFloat(float64(v)/float64(time.Millisecond), 'f', 3, 64)
+		}
+
+		for _, i := range intervals {
+			h := merged[i]
+			row := []string{strconv.FormatInt(i.sec, 10), i.route, strconv.FormatInt(h.TotalCount(), 10)}
+			for _, p := range percentiles {
+				row = append(row, ms(h.ValueAtPercentile(p)))
+			}
+			cw.Write(append(row, ms(h.Max())))
+		}
+
+		cw.Flush()
+		return cw.Error()
+	})
+}
+
+func writeFile(path string, write func(w io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	buf := bufio.NewWriter(f)
+	if err := write(buf); err != nil {
+		return err
+	}
+	if err := buf.Flush(); err != nil {
+		return err
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+// openModel sends requests at a scheduled rate whatever the server does, like
+// k6's arrival rate executors, so a slow server shows up as latency instead
+// of as fewer requests.
+type openModel struct {
+	stages      stages
+	arrival     string
+	maxInFlight int
+	late        time.Duration
+	seed        uint64
+}
+
+func runOpen(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("loadgen open", flag.ExitOnError)
+
+	var opts options
+	opts.register(fs)
+
+	var m openModel
+	fs.Var(&m.stages, "stages", "duration:target request rate ramp in req/s, as in k6")
+	m.register(fs)
+	fs.Parse(args)
+
+	if len(m.stages) == 0 {
+		return errors.New("stages is required")
+	}
+	if err := m.validate(); err != nil {
+		return err
+	}
+
+	run, rec, err := opts.start(m.maxInFlight)
+	if err != nil {
+		return err
+	}
+
+	late := m.run(ctx, run)
+	log.Printf("%d requests sent more than %s behind schedule", late, m.late)
+
+	return rec.finish()
+}
+
+// register adds the flags of the arrivals, which saturate shares.
+func (m *openModel) register(fs *flag.FlagSet) {
+	fs.StringVar(&m.arrival, "arrival", "poisson", "inter-arrival times: poisson, exponential gaps averaging the rate, or fixed")
+	fs.IntVar(&m.maxInFlight, "max-in-flight", 3000, "requests waiting for an answer at once; arrivals beyond it are dropped")
+	fs.DurationVar(&m.late, "late", 10*time.Millisecond, "how far behind schedule a request is sent before it counts as late")
+	fs.Uint64Var(&m.seed, "seed", 1, "seed of the poisson arrivals")
+}
+
+func (m *openModel) validate() error {
+	if m.arrival != "poisson" && m.arrival != "fixed" {
+		return fmt.Errorf("unknown arrival %q, expected poisson or fixed", m.arrival)
+	}
+	if m.maxInFlight < 1 {
+		return errors.New("max-in-flight must be at least 1")
+	}
+
+	return nil
+}
+
+// idleStep is how far the schedule moves on while the rate is 0.
+const idleStep = 10 * time.Millisecond
+
+// run sends the requests and returns how many went out late. Arrivals that
+// find max-in-flight requests waiting are recorded as dropped, and the ones
+// the generator itself falls behind on are sent at once, counted as late and
+// timed from when they were due.
+func (m openModel) run(ctx context.Context, run *run) int {
+	rng := rand.New(rand.NewPCG(m.seed, m.seed))
+	slots := make(chan struct{}, m.maxInFlight)
+
+	var (
+		wg   sync.WaitGroup
+		late int
+		n    int
+	)
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	start := time.Now()
+	var offset time.Duration
+	for ctx.Err() == nil {
+		rate, ok := m.stages.at(offset)
+		if !ok {
+			break
+		}
+		if rate <= 0 {
+			offset += idleStep
+			continue
+		}
+
+		gap := 1 / rate
+		if m.arrival == "poisson" {
+			gap = rng.ExpFloat64() / rate
+		}
+		offset += time.Duration(gap * float64(time.Second))
+
+		scheduled := start.Add(offset)
+		if wait := time.Until(scheduled); wait > 0 {
+			timer.Reset(wait)
+			select {
+			case <-ctx.Done():
+				continue
+			case <-timer.C:
+			}
+		} else if -wait > m.late {
+			late++
+		}
+
+		select {
+		case slots <- struct{}{}:
+		default:
+			run.record(result{start: scheduled, dropped: true})
+			continue
+		}
+
+		path := run.requests[n%len(run.requests)]
+		n++
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			run.record(run.client.get(path, scheduled))
+			<-slots
+		}()
+	}
+
+	wg.Wait()
+	return late
+}
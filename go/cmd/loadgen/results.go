@@ -0,0 +1,166 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"log"
+	"os"
+	"strconv"
+	"time"
+)
+
+// csvHeader is the header of k6's CSV output, which bench/index.ts converts
+// to parquet and bench/prepare.ts reads metric_name, timestamp, metric_value
+// and status from.
+var csvHeader = []string{"metric_name", "timestamp", "metric_value", "check", "error", "error_code", "expected_response", "group", "method", "name", "proto", "scenario", "service", "status", "subproto", "tls_version", "url", "extra_tags", "metadata"}
+
+// summary totals what a recorder has written.
+type summary struct {
+	requests int
+	failed   int
+	dropped  int
+	first    time.Time
+	last     time.Time
+}
+
+func (s summary) rate() float64 {
+	if !s.last.After(s.first) {
+		return 0
+	}
+	return float64(s.requests) / s.last.Sub(s.first).Seconds()
+}
+
+// recorder writes results from a single goroutine, so the request loops only
+// pay for a channel send.
+type recorder struct {
+	results chan result
+	done    chan error
+	summary summary
+	// hdr, when set, also gets the latency of every request.
+	hdr *histograms
+}
+
+func newRecorder(path, host string, hdr *histograms) (*recorder, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &recorder{
+		results: make(chan result, 1<<16),
+		done:    make(chan error, 1),
+		hdr:     hdr,
+	}
+	go func() {
+		r.done <- r.write(f, host)
+	}()
+
+	return r, nil
+}
+
+func (r *recorder) record(res result) {
+	r.results <- res
+}
+
+// finish flushes the results and prints their totals.
+func (r *recorder) finish() error {
+	s, err := r.close()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("%d requests, %d failed, %d dropped, %.0f req/s", s.requests, s.failed, s.dropped, s.rate())
+	return nil
+}
+
+// close waits for every recorded result to be written.
+func (r *recorder) close() (summary, error) {
+	close(r.results)
+	err := <-r.done
+	return r.summary, err
+}
+
+// write turns every request into the http_reqs, http_req_duration and
+// http_req_failed rows k6 writes for it, with the route as the name tag, and
+// every dropped arrival into a dropped_iterations row.
+func (r *recorder) write(f *os.File, host string) error {
+	defer f.Close()
+
+	buf := bufio.NewWriterSize(f, 1<<20)
+	w := csv.NewWriter(buf)
+	w.Write(csvHeader)
+
+	var hdrErr error
+
+	row := make([]string, len(csvHeader))
+	for res := range r.results {
+		if res.dropped {
+			r.summary.dropped++
+
+			clear(row)
+			row[0] = "dropped_iterations"
+			row[1] = strconv.FormatInt(res.start.Unix(), 10)
+			row[2] = "1.000000"
+			row[11] = "default"
+			w.Write(row)
+			continue
+		}
+
+		r.summary.requests++
+		if r.hdr != nil && hdrErr == nil {
+			hdrErr = r.hdr.record(res)
+		}
+		if r.summary.first.IsZero() || res.start.Before(r.summary.first) {
+			r.summary.first = res.start
+		}
+		if end := res.start.Add(res.duration); end.After(r.summary.last) {
+			r.summary.last = end
+		}
+
+		clear(row)
+		row[1] = strconv.FormatInt(res.start.Unix(), 10)
+		row[6] = strconv.FormatBool(!res.failed())
+		row[8] = "GET"
+		row[9] = res.route()
+		row[10] = "HTTP/1.1"
+		row[11] = "default"
+		row[13] = strconv.Itoa(res.status)
+		row[16] = host + res.path
+		if res.err != nil {
+			row[4] = res.err.Error()
+		}
+
+		failed := "0.000000"
+		if res.failed() {
+			r.summary.failed++
+			failed = "1.000000"
+		}
+
+		for _, metric := range [...][2]string{
+			{"http_reqs", "1.000000"},
+			{"http_req_duration", strconv.FormatFloat(float64(res.duration)/float64(time.Millisecond), 'f', 6, 64)},
+			{"http_req_failed", failed},
+		} {
+			row[0], row[2] = metric[0], metric[1]
+			w.Write(row)
+		}
+	}
+
+	w.Flush()
+	err := w.Error()
+	if err == nil {
+		err = buf.Flush()
+	}
+	if err == nil {
+		err = f.Close()
+	}
+
+	// The HDR log is closed even after a failed record, so that the CSV and
+	// whatever intervals made it into the log are complete on disk.
+	if r.hdr != nil {
+		err = errors.Join(err, hdrErr, r.hdr.close())
+	}
+
+	return err
+}
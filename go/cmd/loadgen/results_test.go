@@ -0,0 +1,47 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRecorderFlushesAfterHDRFailure(t *testing.T) {
+	dir := t.TempDir()
+
+	h, err := newHistograms(filepath.Join(dir, "run.hlog"), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Writes to the log fail once its buffer fills up.
+	h.f.Close()
+
+	path := filepath.Join(dir, "run.csv")
+	r, err := newRecorder(path, "http://localhost:3002", h)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const requests = 1000
+	for i := range requests {
+		r.record(ok("/customers", time.Duration(i)*time.Second, time.Millisecond))
+	}
+
+	s, err := r.close()
+	if err == nil {
+		t.Error("no error from the failed HDR log")
+	}
+	if s.requests != requests {
+		t.Errorf("%d requests summed, want %d", s.requests, requests)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := bytes.Count(data, []byte("\n")); lines != 1+3*requests {
+		t.Errorf("the CSV has %d lines, want %d", lines, 1+3*requests)
+	}
+}
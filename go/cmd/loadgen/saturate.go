@@ -0,0 +1,362 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"maps"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/HdrHistogram/hdrhistogram-go"
+)
+
+// slo is a target a rate is sustainable under, such as p99<50ms,errors<0.1%.
+// A zero latency or a negative error rate leaves that side unchecked.
+type slo struct {
+	text       string
+	percentile float64
+	latency    time.Duration
+	errorRate  float64
+}
+
+func parseSLO(s string) (slo, error) {
+	t := slo{text: s, errorRate: -1}
+	for clause := range strings.SplitSeq(s, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(clause), "<")
+		if !ok {
+			return t, fmt.Errorf("target clause %q is not name<limit", clause)
+		}
+
+		switch {
+		case name == "errors":
+			rate, err := strconv.ParseFloat(strings.TrimSuffix(value, "%"), 64)
+			if err != nil || !strings.HasSuffix(value, "%") {
+				return t, fmt.Errorf("error rate %q is not a percentage", value)
+			}
+			t.errorRate = rate / 100
+		case strings.HasPrefix(name, "p"):
+			p, err := strconv.ParseFloat(name[1:], 64)
+			if err != nil || p <= 0 || p > 100 {
+				return t, fmt.Errorf("percentile %q is not between p0 and p100", name)
+			}
+			latency, err := time.ParseDuration(value)
+			if err != nil {
+				return t, err
+			}
+			t.percentile, t.latency = p, latency
+		default:
+			return t, fmt.Errorf("unknown target %q, expected pNN or errors", name)
+		}
+	}
+
+	return t, nil
+}
+
+func (t slo) met(p *probe) bool {
+	if t.latency > 0 && time.Duration(p.hist.ValueAtPercentile(t.percentile)) >= t.latency {
+		return false
+	}
+	if t.errorRate >= 0 && p.errorRate() >= t.errorRate {
+		return false
+	}
+	return true
+}
+
+// slos is a repeatable flag.Value.
+type slos []slo
+
+func (s *slos) String() string {
+	if s == nil {
+		return ""
+	}
+
+	texts := make([]string, len(*s))
+	for i, t := range *s {
+		texts[i] = t.text
+	}
+	return strings.Join(texts, " ")
+}
+
+func (s *slos) Set(v string) error {
+	t, err := parseSLO(v)
+	if err != nil {
+		return err
+	}
+
+	*s = append(*s, t)
+	return nil
+}
+
+// probe is what one arrival rate did in the window after its warm-up.
+type probe struct {
+	rate float64
+	from time.Time
+	hold time.Duration
+
+	mu       sync.Mutex
+	hist     *hdrhistogram.Histogram
+	requests int
+	failed   int
+	dropped  int
+	late     int
+}
+
+func (p *probe) record(res result) {
+	if res.start.Before(p.from) {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	switch {
+	case res.dropped:
+		p.dropped++
+	case res.failed():
+		p.requests++
+		p.failed++
+	default:
+		p.requests++
+		p.hist.RecordValue(min(int64(res.duration), highestLatency))
+	}
+}
+
+// errorRate counts dropped arrivals as errors, since the server did not keep
+// up with them.
+func (p *probe) errorRate() float64 {
+	total := p.requests + p.dropped
+	if total == 0 {
+		return 1
+	}
+	return float64(p.failed+p.dropped) / float64(total)
+}
+
+func (p *probe) throughput() float64 {
+	return float64(p.requests-p.failed) / p.hold.Seconds()
+}
+
+// saturation binary-searches the highest arrival rate that meets each target.
+type saturation struct {
+	openModel
+	target
+
+	targets   slos
+	minRate   float64
+	maxRate   float64
+	precision float64
+	warmUp    time.Duration
+	hold      time.Duration
+	cooldown  time.Duration
+
+	// run is shared by the probes, which each point its sink at themselves.
+	run *run
+
+	// probes keeps every rate tried, so the targets share them.
+	probes map[float64]*probe
+}
+
+func runSaturate(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("loadgen saturate", flag.ExitOnError)
+
+	var s saturation
+	s.target.register(fs)
+	s.openModel.register(fs)
+	fs.Var(&s.targets, "target", "SLO to find the highest sustainable rate for, as p99<50ms,errors<0.1%; repeatable (default p99<50ms,errors<0.1%)")
+	fs.Float64Var(&s.minRate, "min-rate", 100, "rate in req/s the search starts from")
+	fs.Float64Var(&s.maxRate, "max-rate", 100000, "rate in req/s the search gives up above")
+	fs.Float64Var(&s.precision, "precision", 0.05, "relative gap between a passing and a failing rate the search stops at")
+	fs.DurationVar(&s.warmUp, "warm-up", 5*time.Second, "time every probe ramps up to its rate, left out of its measurement")
+	fs.DurationVar(&s.hold, "hold", 15*time.Second, "time every probe is measured at its rate")
+	fs.DurationVar(&s.cooldown, "cooldown", 2*time.Second, "pause between probes for the server to drain")
+	curve := fs.String("curve", "", "CSV file to write every probe to")
+	fs.Parse(args)
+
+	if len(s.targets) == 0 {
+		s.targets.Set("p99<50ms,errors<0.1%")
+	}
+	if err := s.openModel.validate(); err != nil {
+		return err
+	}
+	if s.minRate <= 0 || s.maxRate < s.minRate {
+		return errors.New("rates must satisfy 0 < min-rate <= max-rate")
+	}
+	if s.precision <= 0 || s.warmUp <= 0 || s.hold <= 0 {
+		return errors.New("precision, warm-up and hold must be positive")
+	}
+
+	run, err := s.target.start(s.maxInFlight, nil)
+	if err != nil {
+		return err
+	}
+	s.run = run
+	s.probes = map[float64]*probe{}
+
+	found := make([]float64, len(s.targets))
+	for i, t := range s.targets {
+		rate, err := search(s.minRate, s.maxRate, s.precision, func(rate float64) (bool, error) {
+			return s.try(ctx, t, rate)
+		})
+		if err != nil {
+			return err
+		}
+		found[i] = rate
+	}
+
+	if *curve != "" {
+		if err := writeFile(*curve, s.writeCurve); err != nil {
+			return err
+		}
+	}
+
+	s.printCurve(os.Stdout)
+	fmt.Println()
+	for i, t := range s.targets {
+		fmt.Printf("%s: max sustainable %.0f req/s\n", t.text, found[i])
+	}
+
+	return nil
+}
+
+// search doubles the rate from minRate until try fails, then bisects between
+// the last passing and the first failing rate down to precision, or to 1 req/s
+// since the rates are whole. It returns 0 when minRate already fails and
+// maxRate when that still passes.
+func search(minRate, maxRate, precision float64, try func(rate float64) (bool, error)) (float64, error) {
+	pass, fail := 0.0, math.Inf(1)
+
+	for rate := minRate; ; rate = min(rate*2, maxRate) {
+		ok, err := try(rate)
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			fail = rate
+			break
+		}
+
+		pass = rate
+		if rate == maxRate {
+			return pass, nil
+		}
+	}
+
+	for pass > 0 && fail-pass > 1 && (fail-pass)/pass > precision {
+		rate := math.Round((pass + fail) / 2)
+		if rate == pass || rate == fail {
+			break
+		}
+
+		ok, err := try(rate)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			pass = rate
+		} else {
+			fail = rate
+		}
+	}
+
+	return pass, nil
+}
+
+func (s *saturation) try(ctx context.Context, t slo, rate float64) (bool, error) {
+	p, ok := s.probes[rate]
+	if !ok {
+		var err error
+		if p, err = s.probe(ctx, rate); err != nil {
+			return false, err
+		}
+		s.probes[rate] = p
+	}
+
+	met := t.met(p)
+	log.Printf("%s at %.0f req/s: p99 %s, %.3f%% errors, %t", t.text, rate, time.Duration(p.hist.ValueAtPercentile(99)), 100*p.errorRate(), met)
+	return met, nil
+}
+
+// probe ramps up to rate over the warm-up and holds it, measuring only the
+// hold.
+func (s *saturation) probe(ctx context.Context, rate float64) (*probe, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	p := &probe{
+		rate: rate,
+		from: time.Now().Add(s.warmUp),
+		hold: s.hold,
+		hist: newHistogram(),
+	}
+
+	s.run.sink = p
+
+	m := s.openModel
+	m.stages = stages{{s.warmUp, int(rate)}, {s.hold, int(rate)}}
+	p.late = m.run(ctx, s.run)
+
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(s.cooldown):
+	}
+
+	return p, nil
+}
+
+func (s *saturation) sortedProbes() []*probe {
+	return slices.SortedFunc(maps.Values(s.probes), func(a, b *probe) int {
+		return cmp.Compare(a.rate, b.rate)
+	})
+}
+
+func (s *saturation) printCurve(w io.Writer) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprint(tw, "rate\tthroughput\tp50\tp90\tp99\terrors\tdropped\tlate\t\n")
+	for _, p := range s.sortedProbes() {
+		fmt.Fprintf(tw, "%.0f\t%.0f\t%s\t%s\t%s\t%.3f%%\t%d\t%d\t\n",
+			p.rate, p.throughput(),
+			time.Duration(p.hist.ValueAtPercentile(50)),
+			time.Duration(p.hist.ValueAtPercentile(90)),
+			time.Duration(p.hist.ValueAtPercentile(99)),
+			100*p.errorRate(), p.dropped, p.late)
+	}
+	tw.Flush()
+}
+
+func (s *saturation) writeCurve(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"rate", "throughput", "latency_50", "latency_90", "latency_95", "latency_99", "error_rate", "dropped", "late"})
+
+	ms := func(v int64) string {
+		return strconv.FormatFloat(float64(v)/float64(time.Millisecond), 'f', 3, 64)
+	}
+
+	for _, p := range s.sortedProbes() {
+		row := []string{strconv.FormatFloat(p.rate, 'f', 0, 64), strconv.FormatFloat(p.throughput(), 'f', 1, 64)}
+		for _, q := range percentiles {
+			row = append(row, ms(p.hist.ValueAtPercentile(q)))
+		}
+		row = append(row, strconv.FormatFloat(p.errorRate(), 'f', 6, 64), strconv.Itoa(p.dropped), strconv.Itoa(p.late))
+		cw.Write(row)
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		name      string
+		min, max  float64
+		precision float64
+		capacity  float64
+		want      float64
+	}{
+		{"fails at min-rate", 100, 100000, 0.05, 50, 0},
+		{"passes at max-rate", 100, 100000, 0.05, 200000, 100000},
+		{"max-rate between doublings", 100, 1000, 0.05, 1000, 1000},
+		{"within precision", 100, 100000, 0.05, 1030, 1000},
+		// Bisecting 10 and 11 would round back to 11 forever.
+		{"one apart", 1, 100000, 0.0001, 10.4, 10},
+		{"finer than whole rates", 1, 100000, 0.0001, 2000, 2000},
+		{"fractional min-rate", 0.4, 100000, 0.0001, 1.3, 0.8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tried := map[float64]bool{}
+			got, err := search(tt.min, tt.max, tt.precision, func(rate float64) (bool, error) {
+				if tried[rate] {
+					t.Fatalf("tried %v twice", rate)
+				}
+				tried[rate] = true
+				return rate <= tt.capacity, nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("search = %v, want %v (tried %v)", got, tt.want, tried)
+			}
+		})
+	}
+}
+
+func TestSearchError(t *testing.T) {
+	want := errors.New("interrupted")
+	_, err := search(100, 100000, 0.05, func(rate float64) (bool, error) {
+		if rate > 400 {
+			return false, want
+		}
+		return true, nil
+	})
+	if !errors.Is(err, want) {
+		t.Errorf("error = %v, want %v", err, want)
+	}
+}
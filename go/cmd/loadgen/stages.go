@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// stage moves the target linearly from the one of the previous stage, or 0,
+// to target over duration, like a k6 stage.
+type stage struct {
+	duration time.Duration
+	target   int
+}
+
+// stages is a flag.Value written as duration:target pairs, 5s:200,15s:200.
+type stages []stage
+
+// benchStages is the ramp of bench/bench.js: 200 more VUs every 20s, 5s to
+// ramp and 15s to hold, up to 3000, which is held for 55s.
+func benchStages() stages {
+	var s stages
+	for target := 200; target <= 3000; target += 200 {
+		hold := 15 * time.Second
+		if target == 3000 {
+			hold = 55 * time.Second
+		}
+		s = append(s, stage{5 * time.Second, target}, stage{hold, target})
+	}
+
+	return s
+}
+
+func (s *stages) String() string {
+	if s == nil {
+		return ""
+	}
+
+	parts := make([]string, len(*s))
+	for i, st := range *s {
+		parts[i] = st.duration.String() + ":" + strconv.Itoa(st.target)
+	}
+
+	return strings.Join(parts, ",")
+}
+
+func (s *stages) Set(v string) error {
+	var parsed stages
+	for part := range strings.SplitSeq(v, ",") {
+		d, t, ok := strings.Cut(strings.TrimSpace(part), ":")
+		if !ok {
+			return fmt.Errorf("stage %q is not duration:target", part)
+		}
+
+		duration, err := time.ParseDuration(d)
+		if err != nil {
+			return err
+		}
+		target, err := strconv.Atoi(t)
+		if err != nil {
+			return err
+		}
+		if duration <= 0 || target < 0 {
+			return fmt.Errorf("stage %q needs a positive duration and a target of at least 0", part)
+		}
+
+		parsed = append(parsed, stage{duration, target})
+	}
+
+	*s = parsed
+	return nil
+}
+
+func (s stages) total() time.Duration {
+	var d time.Duration
+	for _, st := range s {
+		d += st.duration
+	}
+	return d
+}
+
+func (s stages) max() int {
+	m := 0
+	for _, st := range s {
+		m = max(m, st.target)
+	}
+	return m
+}
+
+// at is the target elapsed into the run, and false once the last stage is
+// over.
+func (s stages) at(elapsed time.Duration) (float64, bool) {
+	from := 0.0
+	for _, st := range s {
+		if elapsed < st.duration {
+			progress := float64(elapsed) / float64(st.duration)
+			return from + (float64(st.target)-from)*progress, true
+		}
+
+		elapsed -= st.duration
+		from = float64(st.target)
+	}
+
+	return from, false
+}
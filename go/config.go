@@ -0,0 +1,288 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"perf-drizzle/go/db"
+	"perf-drizzle/go/sqlitedb"
+	"perf-drizzle/go/stdlibdb"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/gofiber/fiber/v3"
+)
+
+// envPrefix starts the environment variable of every flag, which is the flag
+// name in upper snake case: --pool-max-conns is PERF_POOL_MAX_CONNS.
+const envPrefix = "PERF_"
+
+// Config is everything a run can tune without a rebuild. Every field is a
+// flag, which the environment and then a --config file fill in when it is not
+// given on the command line.
+type Config struct {
+	Port      int
+	Backend   string
+	Relations db.RelationMode
+	ExecMode  db.ExecMode
+
+	Pool    db.PoolConfig
+	SQLPool stdlibdb.PoolConfig
+
+	SQLiteFile      string
+	SQLiteReadConns int
+
+	Concurrency     int
+	ReadBufferSize  int
+	WriteBufferSize int
+	JSONEngine      string
+	RequestTimeout  time.Duration
+	DrainDelay      time.Duration
+	ShutdownTimeout time.Duration
+}
+
+func defaultConfig() Config {
+	return Config{
+		Port:            3002,
+		Backend:         "sqlc",
+		Relations:       db.RelationsRaw,
+		Pool:            db.DefaultPoolConfig,
+		SQLPool:         stdlibdb.DefaultPoolConfig,
+		SQLiteFile:      sqlitedb.DefaultPath,
+		SQLiteReadConns: runtime.NumCPU(),
+		Concurrency:     fiber.DefaultConcurrency,
+		ReadBufferSize:  fiber.DefaultReadBufferSize,
+		WriteBufferSize: fiber.DefaultWriteBufferSize,
+		JSONEngine:      "sonic",
+		RequestTimeout:  30 * time.Second,
+		ShutdownTimeout: 10 * time.Second,
+	}
+}
+
+func (c *Config) register(fs *flag.FlagSet) {
+	fs.IntVar(&c.Port, "port", c.Port, "port to listen on")
+	fs.StringVar(&c.Backend, "backend", c.Backend, "data access backend: "+backendNames())
+	fs.TextVar(&c.Relations, "relations", c.Relations, "how relation columns are served: raw or typed")
+	fs.TextVar(&c.ExecMode, "exec-mode", c.ExecMode, "sqlc backend: pgx query execution mode, one of cache_statement, cache_describe, describe_exec, exec, simple_protocol (default from DATABASE_URL, else cache_statement)")
+
+	int32Var(fs, &c.Pool.MinConns, "pool-min-conns", "pgx backends: connections kept open when idle")
+	int32Var(fs, &c.Pool.MaxConns, "pool-max-conns", "pgx backends: maximum open connections")
+	fs.DurationVar(&c.Pool.MaxConnLifetime, "pool-max-conn-lifetime", c.Pool.MaxConnLifetime, "pgx backends: maximum connection lifetime")
+	fs.DurationVar(&c.Pool.MaxConnIdleTime, "pool-max-conn-idle-time", c.Pool.MaxConnIdleTime, "pgx backends: maximum connection idle time")
+	fs.DurationVar(&c.Pool.HealthCheckPeriod, "pool-health-check-period", c.Pool.HealthCheckPeriod, "pgx backends: interval between idle connection health checks")
+	fs.BoolVar(&c.Pool.WarmUp, "pool-warm-up", c.Pool.WarmUp, "sqlc backend: open pool-min-conns connections, preparing every query on them under cache_statement, before listening")
+
+	fs.IntVar(&c.SQLPool.MaxOpenConns, "sql-max-open-conns", c.SQLPool.MaxOpenConns, "database/sql backends: maximum open connections")
+	fs.IntVar(&c.SQLPool.MaxIdleConns, "sql-max-idle-conns", c.SQLPool.MaxIdleConns, "database/sql backends: maximum idle connections")
+	fs.DurationVar(&c.SQLPool.ConnMaxLifetime, "sql-conn-max-lifetime", c.SQLPool.ConnMaxLifetime, "database/sql backends: maximum connection lifetime, 0 for unlimited")
+	fs.DurationVar(&c.SQLPool.ConnMaxIdleTime, "sql-conn-max-idle-time", c.SQLPool.ConnMaxIdleTime, "database/sql backends: maximum connection idle time, 0 for unlimited")
+
+	fs.StringVar(&c.SQLiteFile, "sqlite-file", c.SQLiteFile, "sqlite backend: database file seeded by src/sqlite/seed.ts")
+	fs.IntVar(&c.SQLiteReadConns, "sqlite-read-conns", c.SQLiteReadConns, "sqlite backend: read connections")
+
+	fs.IntVar(&c.Concurrency, "concurrency", c.Concurrency, "maximum concurrent connections the server accepts")
+	fs.IntVar(&c.ReadBufferSize, "read-buffer-size", c.ReadBufferSize, "per connection buffer for request headers, in bytes")
+	fs.IntVar(&c.WriteBufferSize, "write-buffer-size", c.WriteBufferSize, "per connection buffer for responses, in bytes")
+	fs.StringVar(&c.JSONEngine, "json-engine", c.JSONEngine, "JSON encoder of the responses: "+strings.Join(slices.Sorted(maps.Keys(jsonEngines)), ", "))
+	fs.DurationVar(&c.RequestTimeout, "request-timeout", c.RequestTimeout, "deadline of the database work of a request: 503 if it is still waiting for a pooled connection then, 504 if its query is still running, 0 for none")
+	fs.DurationVar(&c.DrainDelay, "drain-delay", c.DrainDelay, "how long the server keeps serving on SIGTERM with /readyz at 503, for load balancers to take it out, before it drains")
+	fs.DurationVar(&c.ShutdownTimeout, "shutdown-timeout", c.ShutdownTimeout, "how long requests in flight get to finish on SIGTERM before their connections are closed")
+}
+
+// loadConfig reads the flags in args, then fills in the ones not given from
+// the environment and then from the --config file, and validates the result.
+func loadConfig(fs *flag.FlagSet, args []string) (Config, error) {
+	cfg := defaultConfig()
+	cfg.register(fs)
+	file := fs.String("config", os.Getenv(envPrefix+"CONFIG"), "JSON file mapping flag names to values; every flag can also be set from the environment, as "+envPrefix+"PORT for --port")
+
+	if err := fs.Parse(args); err != nil {
+		return cfg, err
+	}
+
+	given := map[string]string{}
+	fs.Visit(func(f *flag.Flag) {
+		given[f.Name] = f.Value.String()
+	})
+
+	if *file != "" {
+		if err := applyFile(fs, *file); err != nil {
+			return cfg, err
+		}
+	}
+
+	if err := applyEnv(fs); err != nil {
+		return cfg, err
+	}
+
+	for name, value := range given {
+		fs.Set(name, value)
+	}
+
+	return cfg, cfg.validate()
+}
+
+func applyFile(fs *flag.FlagSet, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var values map[string]any
+	dec := json.NewDecoder(f)
+	dec.UseNumber()
+	if err := dec.Decode(&values); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	for name, v := range values {
+		if name == "config" || fs.Lookup(name) == nil {
+			return fmt.Errorf("%s: unknown setting %q", path, name)
+		}
+
+		var value string
+		switch v := v.(type) {
+		case string:
+			value = v
+		case json.Number:
+			value = v.String()
+		case bool:
+			value = strconv.FormatBool(v)
+		default:
+			return fmt.Errorf("%s: %s must be a string or a number", path, name)
+		}
+
+		if err := fs.Set(name, value); err != nil {
+			return fmt.Errorf("%s: %s: %w", path, name, err)
+		}
+	}
+
+	return nil
+}
+
+func applyEnv(fs *flag.FlagSet) error {
+	var err error
+	fs.VisitAll(func(f *flag.Flag) {
+		if f.Name == "config" || err != nil {
+			return
+		}
+
+		name := envPrefix + strings.ToUpper(strings.ReplaceAll(f.Name, "-", "_"))
+		if value, ok := os.LookupEnv(name); ok {
+			if e := fs.Set(f.Name, value); e != nil {
+				err = fmt.Errorf("%s: %w", name, e)
+			}
+		}
+	})
+
+	return err
+}
+
+func (c *Config) validate() error {
+	var errs []error
+
+	if c.Port < 1 || c.Port > 65535 {
+		errs = append(errs, fmt.Errorf("port must be between 1 and 65535, got %d", c.Port))
+	}
+	if _, ok := backends[c.Backend]; !ok {
+		errs = append(errs, fmt.Errorf("unknown backend %q, expected one of: %s", c.Backend, backendNames()))
+	}
+	if err := c.Pool.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("pool: %w", err))
+	}
+	if c.SQLPool.MaxOpenConns < 0 || c.SQLPool.MaxIdleConns < 0 {
+		errs = append(errs, errors.New("sql pool connection counts must not be negative"))
+	}
+	if c.SQLiteReadConns < 1 {
+		errs = append(errs, fmt.Errorf("sqlite read conns must be at least 1, got %d", c.SQLiteReadConns))
+	}
+	if c.Concurrency < 1 || c.ReadBufferSize < 1 || c.WriteBufferSize < 1 {
+		errs = append(errs, errors.New("concurrency and buffer sizes must be positive"))
+	}
+	if _, ok := jsonEngines[c.JSONEngine]; !ok {
+		errs = append(errs, fmt.Errorf("unknown json engine %q", c.JSONEngine))
+	}
+	if c.RequestTimeout < 0 {
+		errs = append(errs, fmt.Errorf("request timeout must not be negative, got %s", c.RequestTimeout))
+	}
+	if c.DrainDelay < 0 {
+		errs = append(errs, fmt.Errorf("drain delay must not be negative, got %s", c.DrainDelay))
+	}
+	if c.ShutdownTimeout <= 0 {
+		errs = append(errs, fmt.Errorf("shutdown timeout must be positive, got %s", c.ShutdownTimeout))
+	}
+
+	return errors.Join(errs...)
+}
+
+// effectiveConfig lists every flag with its value, in the form a --config
+// file takes. execMode is the mode the sqlc backend runs in, which when the
+// flag is empty comes from DATABASE_URL.
+func effectiveConfig(fs *flag.FlagSet, execMode db.ExecMode) map[string]any {
+	values := map[string]any{}
+	fs.VisitAll(func(f *flag.Flag) {
+		if f.Name == "config" {
+			return
+		}
+
+		v := f.Value.(flag.Getter).Get()
+		if d, ok := v.(time.Duration); ok {
+			v = d.String()
+		}
+		values[f.Name] = v
+	})
+	if execMode != "" {
+		values["exec-mode"] = execMode
+	}
+
+	return values
+}
+
+type jsonEngine struct {
+	marshal   func(v any) ([]byte, error)
+	unmarshal func(data []byte, v any) error
+}
+
+// jsonEngines are the encoders the responses can be written with. Postgres
+// json columns are decoded with sonic whatever the choice.
+var jsonEngines = map[string]jsonEngine{
+	"sonic":         {sonic.ConfigDefault.Marshal, sonic.ConfigDefault.Unmarshal},
+	"sonic-std":     {sonic.ConfigStd.Marshal, sonic.ConfigStd.Unmarshal},
+	"encoding-json": {json.Marshal, json.Unmarshal},
+}
+
+// int32Value is a flag.Value over the int32 fields of pgxpool's settings.
+type int32Value int32
+
+func int32Var(fs *flag.FlagSet, p *int32, name, usage string) {
+	fs.Var((*int32Value)(p), name, usage)
+}
+
+func (v *int32Value) Set(s string) error {
+	n, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	*v = int32Value(n)
+	return nil
+}
+
+func (v *int32Value) String() string {
+	if v == nil {
+		return "0"
+	}
+	return strconv.Itoa(int(*v))
+}
+
+func (v *int32Value) Get() any {
+	return int32(*v)
+}
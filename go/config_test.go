@@ -0,0 +1,164 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"perf-drizzle/go/db"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bytedance/sonic"
+)
+
+// writeConfig writes a --config file and returns its path.
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func newFlagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet("server", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	return fs
+}
+
+func TestLoadConfigPrecedence(t *testing.T) {
+	file := writeConfig(t, `{"port": 4001, "backend": "sqlx", "pool-max-conns": 10, "request-timeout": "1s", "pool-warm-up": true, "pool-min-conns": 2}`)
+
+	tests := []struct {
+		name string
+		env  map[string]string
+		args []string
+		want func(c *Config) bool
+	}{
+		{"defaults", nil, nil, func(c *Config) bool {
+			return c.Port == 3002 && c.Backend == "sqlc" && c.Pool.MaxConns == db.DefaultPoolConfig.MaxConns
+		}},
+		{"file", nil, []string{"--config", file}, func(c *Config) bool {
+			return c.Port == 4001 && c.Backend == "sqlx" && c.Pool.MaxConns == 10 && c.RequestTimeout == time.Second && c.Pool.WarmUp
+		}},
+		{"file from the environment", map[string]string{"PERF_CONFIG": file}, nil, func(c *Config) bool {
+			return c.Port == 4001 && c.Backend == "sqlx"
+		}},
+		{"environment over file", map[string]string{"PERF_PORT": "4002", "PERF_POOL_MAX_CONNS": "20"}, []string{"--config", file}, func(c *Config) bool {
+			return c.Port == 4002 && c.Pool.MaxConns == 20 && c.Backend == "sqlx"
+		}},
+		{"flags over environment and file", map[string]string{"PERF_PORT": "4002", "PERF_BACKEND": "gorm"}, []string{"--config", file, "--port", "4003"}, func(c *Config) bool {
+			return c.Port == 4003 && c.Backend == "gorm" && c.Pool.MaxConns == 10
+		}},
+		{"flag set to its default", map[string]string{"PERF_PORT": "4002"}, []string{"--config", file, "--port", "3002"}, func(c *Config) bool {
+			return c.Port == 3002
+		}},
+		{"flag over environment", map[string]string{"PERF_REQUEST_TIMEOUT": "2s"}, []string{"--request-timeout", "0"}, func(c *Config) bool {
+			return c.RequestTimeout == 0
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			cfg, err := loadConfig(newFlagSet(), tt.args)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tt.want(&cfg) {
+				t.Errorf("got %+v", cfg)
+			}
+		})
+	}
+}
+
+func TestLoadConfigErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		env  map[string]string
+		args []string
+		want string
+	}{
+		{"unknown setting", `{"prot": 1}`, nil, nil, `unknown setting "prot"`},
+		{"config in the file", `{"config": "other.json"}`, nil, nil, `unknown setting "config"`},
+		{"bad file value", `{"port": "x"}`, nil, nil, "port"},
+		{"nested file value", `{"port": [1]}`, nil, nil, "must be a string or a number"},
+		{"bad environment value", ``, map[string]string{"PERF_POOL_MAX_CONNS": "many"}, nil, "PERF_POOL_MAX_CONNS"},
+		{"unknown flag", ``, nil, []string{"--nope"}, "nope"},
+		{"invalid result", `{"port": 0}`, nil, nil, "port must be between"},
+		{"invalid from the environment", ``, map[string]string{"PERF_DRAIN_DELAY": "-1s"}, nil, "drain delay"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			args := tt.args
+			if tt.file != "" {
+				args = append([]string{"--config", writeConfig(t, tt.file)}, args...)
+			}
+
+			_, err := loadConfig(newFlagSet(), args)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want one about %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestEffectiveConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		execMode db.ExecMode
+		want     string
+	}{
+		{"from the connection string", nil, db.ExecCacheStatement, "cache_statement"},
+		{"from the flag", []string{"--exec-mode", "exec"}, db.ExecExec, "exec"},
+		{"not the sqlc backend", []string{"--backend", "gorm"}, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := newFlagSet()
+			if _, err := loadConfig(fs, tt.args); err != nil {
+				t.Fatal(err)
+			}
+
+			data, err := sonic.Marshal(effectiveConfig(fs, tt.execMode))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// The output is a valid --config file for the same settings.
+			var values map[string]any
+			if err := sonic.Unmarshal(data, &values); err != nil {
+				t.Fatal(err)
+			}
+			if got := values["exec-mode"]; got != tt.want {
+				t.Errorf("exec-mode = %v, want %q", got, tt.want)
+			}
+			if _, ok := values["config"]; ok {
+				t.Error("config is listed")
+			}
+
+			again, err := loadConfig(newFlagSet(), []string{"--config", writeConfig(t, string(data))})
+			if err != nil {
+				t.Fatalf("reloading %s: %v", data, err)
+			}
+			if again.ExecMode != tt.execMode {
+				t.Errorf("reloaded exec mode %q, want %q", again.ExecMode, tt.execMode)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// AcquireError is returned when no connection could be had from the pool.
+// pgxpool reports that as the bare context error, the same as a query whose
+// context ran out, so without it the server could not tell the two apart.
+type AcquireError struct {
+	Err error
+}
+
+func (e *AcquireError) Error() string {
+	return "acquiring a connection: " + e.Err.Error()
+}
+
+func (e *AcquireError) Unwrap() error {
+	return e.Err
+}
+
+// Acquire is pool.Acquire with its error wrapped in an AcquireError.
+func Acquire(ctx context.Context, pool *pgxpool.Pool) (*pgxpool.Conn, error) {
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return nil, &AcquireError{Err: err}
+	}
+
+	return conn, nil
+}
+
+// pooled is the DBTX of a Client. It runs the queries as *pgxpool.Pool does,
+// but acquires the connection through Acquire.
+type pooled struct {
+	pool *pgxpool.Pool
+}
+
+func (p pooled) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	conn, err := Acquire(ctx, p.pool)
+	if err != nil {
+		return pgconn.CommandTag{}, err
+	}
+	defer conn.Release()
+
+	return conn.Exec(ctx, sql, args...)
+}
+
+func (p pooled) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	conn, err := Acquire(ctx, p.pool)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		conn.Release()
+		return nil, err
+	}
+
+	return &poolRows{Rows: rows, conn: conn}, nil
+}
+
+func (p pooled) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	conn, err := Acquire(ctx, p.pool)
+	if err != nil {
+		return errRow{err: err}
+	}
+
+	return poolRow{row: conn.QueryRow(ctx, sql, args...), conn: conn}
+}
+
+// poolRows releases the connection once the rows are read or closed.
+type poolRows struct {
+	pgx.Rows
+	conn *pgxpool.Conn
+}
+
+func (r *poolRows) Next() bool {
+	if r.Rows.Next() {
+		return true
+	}
+
+	r.Close()
+	return false
+}
+
+func (r *poolRows) Close() {
+	r.Rows.Close()
+	if r.conn != nil {
+		r.conn.Release()
+		r.conn = nil
+	}
+}
+
+// poolRow releases the connection once the row is scanned.
+type poolRow struct {
+	row  pgx.Row
+	conn *pgxpool.Conn
+}
+
+func (r poolRow) Scan(dest ...any) error {
+	defer r.conn.Release()
+
+	return r.row.Scan(dest...)
+}
+
+type errRow struct {
+	err error
+}
+
+func (r errRow) Scan(...any) error {
+	return r.err
+}
@@ -12,17 +12,74 @@ import (
 )
 
 type Client struct {
-	*Queries
-	pool *pgxpool.Pool
+	Searches
+	pool     *pgxpool.Pool
+	execMode ExecMode
+	warmUp   time.Duration
 }
 
-func NewDatabase(databaseUrl string) (*Client, error) {
+// PoolConfig holds the pgxpool settings, which take precedence over the
+// pool_* parameters of the connection string.
+type PoolConfig struct {
+	MinConns          int32
+	MaxConns          int32
+	MaxConnLifetime   time.Duration
+	MaxConnIdleTime   time.Duration
+	HealthCheckPeriod time.Duration
+
+	// WarmUp has NewDatabase open MinConns connections before it returns, and
+	// every connection prepare the queries as it opens when the exec mode is
+	// cache_statement. The other modes are measured as they are.
+	WarmUp bool
+}
+
+// DefaultPoolConfig keeps pgxpool's defaults but for MaxConns, which is sized
+// for the load the benchmark applies.
+var DefaultPoolConfig = PoolConfig{
+	MaxConns:          200,
+	MaxConnLifetime:   time.Hour,
+	MaxConnIdleTime:   30 * time.Minute,
+	HealthCheckPeriod: time.Minute,
+}
+
+// Validate reports settings pgxpool would reject or silently misuse.
+func (p PoolConfig) Validate() error {
+	switch {
+	case p.MaxConns < 1:
+		return fmt.Errorf("max conns must be at least 1, got %d", p.MaxConns)
+	case p.MinConns < 0 || p.MinConns > p.MaxConns:
+		return fmt.Errorf("min conns must be between 0 and max conns %d, got %d", p.MaxConns, p.MinConns)
+	case p.MaxConnLifetime <= 0 || p.MaxConnIdleTime <= 0 || p.HealthCheckPeriod <= 0:
+		return fmt.Errorf("pool durations must be positive")
+	case p.WarmUp && p.MinConns < 1:
+		return fmt.Errorf("warm-up needs min conns of at least 1")
+	}
+
+	return nil
+}
+
+// Apply copies the settings into config.
+func (p PoolConfig) Apply(config *pgxpool.Config) {
+	config.MinConns = p.MinConns
+	config.MaxConns = p.MaxConns
+	config.MaxConnLifetime = p.MaxConnLifetime
+	config.MaxConnIdleTime = p.MaxConnIdleTime
+	config.HealthCheckPeriod = p.HealthCheckPeriod
+}
+
+// NewDatabase connects to databaseUrl. An empty execMode keeps the one the
+// connection string asks for, which pgx defaults to cache_statement.
+func NewDatabase(databaseUrl string, execMode ExecMode, poolConfig PoolConfig) (*Client, error) {
 	config, err := pgxpool.ParseConfig(databaseUrl)
 	if err != nil {
 		return nil, err
 	}
 
-	config.MaxConns = 200
+	poolConfig.Apply(config)
+	if execMode != "" {
+		config.ConnConfig.DefaultQueryExecMode = execModes[execMode]
+	}
+	prepare := poolConfig.WarmUp && config.ConnConfig.DefaultQueryExecMode == pgx.QueryExecModeCacheStatement
 	config.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
 		sonicCodec := &pgtype.JSONCodec{
 			Marshal:   sonic.Marshal,
@@ -43,9 +100,14 @@ func NewDatabase(databaseUrl string) (*Client, error) {
 			Codec: sonicCodec,
 		})
 
+		if prepare {
+			return prepareQueries(ctx, conn)
+		}
+
 		return nil
 	}
 
+	start := time.Now()
 	pool, err := pgxpool.NewWithConfig(context.Background(), config)
 	if err != nil {
 		return nil, err
@@ -60,7 +122,41 @@ func NewDatabase(databaseUrl string) (*Client, error) {
 		return nil, fmt.Errorf("unable to ping database: %w", err)
 	}
 
-	return &Client{pool: pool, Queries: New(pool)}, nil
+	client := &Client{pool: pool, Searches: Searches{Queries: New(pooled{pool})}, execMode: execModeOf(config.ConnConfig.DefaultQueryExecMode)}
+
+	if poolConfig.WarmUp {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		if err = waitForMinConns(ctx, pool, config.MinConns); err != nil {
+			pool.Close()
+
+			return nil, err
+		}
+
+		client.warmUp = time.Since(start)
+	}
+
+	return client, nil
+}
+
+// ExecMode is the query execution mode in effect.
+func (db *Client) ExecMode() ExecMode {
+	return db.execMode
+}
+
+// WarmUp is how long opening the pool and warming it up took, or zero when
+// warm-up is off.
+func (db *Client) WarmUp() time.Duration {
+	return db.warmUp
+}
+
+func (db *Client) Ping(ctx context.Context) error {
+	return db.pool.Ping(ctx)
+}
+
+func (db *Client) Stat() *pgxpool.Stat {
+	return db.pool.Stat()
 }
 
 func (db *Client) Close() {
@@ -0,0 +1,93 @@
+package db
+
+// Conversions from the table models to the query rows, for backends that load
+// tables through their own models rather than the sqlc queries.
+
+func EmployeeRow(e Employee) EmployeesRow {
+	return EmployeesRow{
+		ID:              e.ID,
+		LastName:        e.LastName,
+		FirstName:       e.FirstName,
+		Title:           e.Title,
+		TitleOfCourtesy: e.TitleOfCourtesy,
+		BirthDate:       e.BirthDate,
+		HireDate:        e.HireDate,
+		Address:         e.Address,
+		City:            e.City,
+		PostalCode:      e.PostalCode,
+		Country:         e.Country,
+		HomePhone:       e.HomePhone,
+		Extension:       e.Extension,
+		Notes:           e.Notes,
+		RecipientId:     e.RecipientID,
+	}
+}
+
+func ProductRow(p Product) ProductsRow {
+	return ProductsRow{
+		ID:              p.ID,
+		Name:            p.Name,
+		QuantityPerUnit: p.QtPerUnit,
+		UnitPrice:       p.UnitPrice,
+		UnitsInStock:    p.UnitsInStock,
+		UnitsOnOrder:    p.UnitsOnOrder,
+		ReorderLevel:    p.ReorderLevel,
+		Discontinued:    p.Discontinued,
+		SupplierId:      p.SupplierID,
+	}
+}
+
+func (r EmployeesRow) WithRecipient(recipient RawJSON) EmployeeWithRecipientRow {
+	return EmployeeWithRecipientRow{
+		ID:              r.ID,
+		LastName:        r.LastName,
+		FirstName:       r.FirstName,
+		Title:           r.Title,
+		TitleOfCourtesy: r.TitleOfCourtesy,
+		BirthDate:       r.BirthDate,
+		HireDate:        r.HireDate,
+		Address:         r.Address,
+		City:            r.City,
+		PostalCode:      r.PostalCode,
+		Country:         r.Country,
+		HomePhone:       r.HomePhone,
+		Extension:       r.Extension,
+		Notes:           r.Notes,
+		RecipientId:     r.RecipientId,
+		Recipient:       recipient,
+	}
+}
+
+func (r ProductsRow) WithSupplier(supplier RawJSON) ProductWithSupplierRow {
+	return ProductWithSupplierRow{
+		ID:              r.ID,
+		Name:            r.Name,
+		QuantityPerUnit: r.QuantityPerUnit,
+		UnitPrice:       r.UnitPrice,
+		UnitsInStock:    r.UnitsInStock,
+		UnitsOnOrder:    r.UnitsOnOrder,
+		ReorderLevel:    r.ReorderLevel,
+		Discontinued:    r.Discontinued,
+		SupplierId:      r.SupplierId,
+		Supplier:        supplier,
+	}
+}
+
+func (o Order) WithDetails(details RawJSON) OrderWithDetailsAndProductsRow {
+	return OrderWithDetailsAndProductsRow{
+		ID:             o.ID,
+		OrderDate:      o.OrderDate,
+		RequiredDate:   o.RequiredDate,
+		ShippedDate:    o.ShippedDate,
+		ShipVia:        o.ShipVia,
+		Freight:        o.Freight,
+		ShipName:       o.ShipName,
+		ShipCity:       o.ShipCity,
+		ShipRegion:     o.ShipRegion,
+		ShipPostalCode: o.ShipPostalCode,
+		ShipCountry:    o.ShipCountry,
+		CustomerId:     o.CustomerID,
+		EmployeeId:     o.EmployeeID,
+		Details:        details,
+	}
+}
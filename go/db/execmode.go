@@ -0,0 +1,72 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ExecMode names a pgx.QueryExecMode the way the default_query_exec_mode
+// connection string parameter does.
+type ExecMode string
+
+const (
+	// ExecCacheStatement prepares every query as a named statement on first
+	// use, like the Drizzle server's .prepare() calls. It is pgx's default.
+	ExecCacheStatement ExecMode = "cache_statement"
+	// ExecCacheDescribe caches the description of every query but sends it
+	// through the unnamed statement.
+	ExecCacheDescribe ExecMode = "cache_describe"
+	// ExecDescribeExec describes and executes every query with two round
+	// trips, caching nothing, so it works behind PgBouncer's transaction mode.
+	ExecDescribeExec ExecMode = "describe_exec"
+	// ExecExec executes with the extended protocol in one round trip, with
+	// parameter types taken from the Go arguments.
+	ExecExec ExecMode = "exec"
+	// ExecSimpleProtocol interpolates the arguments client side and sends
+	// them over the simple protocol.
+	ExecSimpleProtocol ExecMode = "simple_protocol"
+)
+
+var execModes = map[ExecMode]pgx.QueryExecMode{
+	ExecCacheStatement: pgx.QueryExecModeCacheStatement,
+	ExecCacheDescribe:  pgx.QueryExecModeCacheDescribe,
+	ExecDescribeExec:   pgx.QueryExecModeDescribeExec,
+	ExecExec:           pgx.QueryExecModeExec,
+	ExecSimpleProtocol: pgx.QueryExecModeSimpleProtocol,
+}
+
+func ParseExecMode(s string) (ExecMode, error) {
+	mode := ExecMode(s)
+	if _, ok := execModes[mode]; !ok {
+		return "", fmt.Errorf("unknown exec mode %q", s)
+	}
+
+	return mode, nil
+}
+
+func (m ExecMode) MarshalText() ([]byte, error) {
+	return []byte(m), nil
+}
+
+// UnmarshalText accepts the empty string as well, for the mode the connection
+// string asks for.
+func (m *ExecMode) UnmarshalText(text []byte) (err error) {
+	if len(text) == 0 {
+		*m = ""
+		return nil
+	}
+
+	*m, err = ParseExecMode(string(text))
+	return err
+}
+
+func execModeOf(m pgx.QueryExecMode) ExecMode {
+	for name, mode := range execModes {
+		if mode == m {
+			return name
+		}
+	}
+
+	return ExecMode(m.String())
+}
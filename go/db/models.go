@@ -4,87 +4,83 @@
 
 package db
 
-import (
-	"github.com/jackc/pgx/v5/pgtype"
-)
-
 type Customer struct {
-	ID           int32
-	CompanyName  string
-	ContactName  string
-	ContactTitle string
-	Address      string
-	City         string
-	PostalCode   pgtype.Text
-	Region       pgtype.Text
-	Country      string
-	Phone        string
-	Fax          pgtype.Text
+	ID           int32   `json:"id"`
+	CompanyName  string  `json:"companyName"`
+	ContactName  string  `json:"contactName"`
+	ContactTitle string  `json:"contactTitle"`
+	Address      string  `json:"address"`
+	City         string  `json:"city"`
+	PostalCode   *string `json:"postalCode"`
+	Region       *string `json:"region"`
+	Country      string  `json:"country"`
+	Phone        string  `json:"phone"`
+	Fax          *string `json:"fax"`
 }
 
 type Employee struct {
-	ID              int32
-	LastName        string
-	FirstName       pgtype.Text
-	Title           string
-	TitleOfCourtesy string
-	BirthDate       pgtype.Date
-	HireDate        pgtype.Date
-	Address         string
-	City            string
-	PostalCode      string
-	Country         string
-	HomePhone       string
-	Extension       int32
-	Notes           string
-	RecipientID     pgtype.Int4
+	ID              int32   `json:"id"`
+	LastName        string  `json:"lastName"`
+	FirstName       *string `json:"firstName"`
+	Title           string  `json:"title"`
+	TitleOfCourtesy string  `json:"titleOfCourtesy"`
+	BirthDate       Date    `json:"birthDate"`
+	HireDate        Date    `json:"hireDate"`
+	Address         string  `json:"address"`
+	City            string  `json:"city"`
+	PostalCode      string  `json:"postalCode"`
+	Country         string  `json:"country"`
+	HomePhone       string  `json:"homePhone"`
+	Extension       int32   `json:"extension"`
+	Notes           string  `json:"notes"`
+	RecipientID     *int32  `json:"recipientId"`
 }
 
 type Order struct {
-	ID             int32
-	OrderDate      pgtype.Date
-	RequiredDate   pgtype.Date
-	ShippedDate    pgtype.Date
-	ShipVia        int32
-	Freight        float64
-	ShipName       string
-	ShipCity       string
-	ShipRegion     pgtype.Text
-	ShipPostalCode pgtype.Text
-	ShipCountry    string
-	CustomerID     int32
-	EmployeeID     int32
+	ID             int32   `json:"id"`
+	OrderDate      Date    `json:"orderDate"`
+	RequiredDate   Date    `json:"requiredDate"`
+	ShippedDate    Date    `json:"shippedDate"`
+	ShipVia        int32   `json:"shipVia"`
+	Freight        float64 `json:"freight"`
+	ShipName       string  `json:"shipName"`
+	ShipCity       string  `json:"shipCity"`
+	ShipRegion     *string `json:"shipRegion"`
+	ShipPostalCode *string `json:"shipPostalCode"`
+	ShipCountry    string  `json:"shipCountry"`
+	CustomerID     int32   `json:"customerId"`
+	EmployeeID     int32   `json:"employeeId"`
 }
 
 type OrderDetail struct {
-	UnitPrice float64
-	Quantity  int32
-	Discount  float64
-	OrderID   int32
-	ProductID int32
+	UnitPrice float64 `json:"unitPrice"`
+	Quantity  int32   `json:"quantity"`
+	Discount  float64 `json:"discount"`
+	OrderID   int32   `json:"orderId"`
+	ProductID int32   `json:"productId"`
 }
 
 type Product struct {
-	ID           int32
-	Name         string
-	QtPerUnit    string
-	UnitPrice    float64
-	UnitsInStock int32
-	UnitsOnOrder int32
-	ReorderLevel int32
-	Discontinued int32
-	SupplierID   int32
+	ID           int32   `json:"id"`
+	Name         string  `json:"name"`
+	QtPerUnit    string  `json:"qtPerUnit"`
+	UnitPrice    float64 `json:"unitPrice"`
+	UnitsInStock int32   `json:"unitsInStock"`
+	UnitsOnOrder int32   `json:"unitsOnOrder"`
+	ReorderLevel int32   `json:"reorderLevel"`
+	Discontinued int32   `json:"discontinued"`
+	SupplierID   int32   `json:"supplierId"`
 }
 
 type Supplier struct {
-	ID           int32
-	CompanyName  string
-	ContactName  string
-	ContactTitle string
-	Address      string
-	City         string
-	Region       pgtype.Text
-	PostalCode   string
-	Country      string
-	Phone        string
+	ID           int32   `json:"id"`
+	CompanyName  string  `json:"companyName"`
+	ContactName  string  `json:"contactName"`
+	ContactTitle string  `json:"contactTitle"`
+	Address      string  `json:"address"`
+	City         string  `json:"city"`
+	Region       *string `json:"region"`
+	PostalCode   string  `json:"postalCode"`
+	Country      string  `json:"country"`
+	Phone        string  `json:"phone"`
 }
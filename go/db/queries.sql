@@ -5,13 +5,16 @@ select "d0"."id" as "id", "d0"."company_name" as "companyName", "d0"."contact_na
 select "d0"."id" as "id", "d0"."company_name" as "companyName", "d0"."contact_name" as "contactName", "d0"."contact_title" as "contactTitle", "d0"."address" as "address", "d0"."city" as "city", "d0"."postal_code" as "postalCode", "d0"."region" as "region", "d0"."country" as "country", "d0"."phone" as "phone", "d0"."fax" as "fax" from "customers" as "d0" where "d0"."id" = $1;
 
 -- name: SearchCustomer :many
-select "d0"."id" as "id", "d0"."company_name" as "companyName", "d0"."contact_name" as "contactName", "d0"."contact_title" as "contactTitle", "d0"."address" as "address", "d0"."city" as "city", "d0"."postal_code" as "postalCode", "d0"."region" as "region", "d0"."country" as "country", "d0"."phone" as "phone", "d0"."fax" as "fax" from "customers" as "d0" where to_tsvector('english', "d0"."company_name") @@ to_tsquery('english', $1);
+select "d0"."id" as "id", "d0"."company_name" as "companyName", "d0"."contact_name" as "contactName", "d0"."contact_title" as "contactTitle", "d0"."address" as "address", "d0"."city" as "city", "d0"."postal_code" as "postalCode", "d0"."region" as "region", "d0"."country" as "country", "d0"."phone" as "phone", "d0"."fax" as "fax" from "customers" as "d0", (select case sqlc.arg(mode)::text when 'plain' then plainto_tsquery('english', sqlc.arg(query)::text) when 'phrase' then phraseto_tsquery('english', sqlc.arg(query)::text) when 'websearch' then websearch_to_tsquery('english', sqlc.arg(query)::text) else to_tsquery('english', sqlc.arg(query)::text) end as "query") as "q" where to_tsvector('english', "d0"."company_name") @@ "q"."query" order by ts_rank(to_tsvector('english', "d0"."company_name"), "q"."query") desc, "d0"."id" asc limit sqlc.arg('limit') offset sqlc.arg('offset');
+
+-- name: SearchCustomerHeadline :many
+select "d0"."id" as "id", "d0"."company_name" as "companyName", "d0"."contact_name" as "contactName", "d0"."contact_title" as "contactTitle", "d0"."address" as "address", "d0"."city" as "city", "d0"."postal_code" as "postalCode", "d0"."region" as "region", "d0"."country" as "country", "d0"."phone" as "phone", "d0"."fax" as "fax", ts_headline('english', "d0"."company_name", "q"."query")::text as "headline" from "customers" as "d0", (select case sqlc.arg(mode)::text when 'plain' then plainto_tsquery('english', sqlc.arg(query)::text) when 'phrase' then phraseto_tsquery('english', sqlc.arg(query)::text) when 'websearch' then websearch_to_tsquery('english', sqlc.arg(query)::text) else to_tsquery('english', sqlc.arg(query)::text) end as "query") as "q" where to_tsvector('english', "d0"."company_name") @@ "q"."query" order by ts_rank(to_tsvector('english', "d0"."company_name"), "q"."query") desc, "d0"."id" asc limit sqlc.arg('limit') offset sqlc.arg('offset');
 
 -- name: Employees :many
 select "d0"."id" as "id", "d0"."last_name" as "lastName", "d0"."first_name" as "firstName", "d0"."title" as "title", "d0"."title_of_courtesy" as "titleOfCourtesy", "d0"."birth_date" as "birthDate", "d0"."hire_date" as "hireDate", "d0"."address" as "address", "d0"."city" as "city", "d0"."postal_code" as "postalCode", "d0"."country" as "country", "d0"."home_phone" as "homePhone", "d0"."extension" as "extension", "d0"."notes" as "notes", "d0"."recipient_id" as "recipientId" from "employees" as "d0" order by "d0"."id" asc limit $1 offset $2;
 
--- name: EmployeeWithRecipient :one
-select "d0"."id" as "id", "d0"."last_name" as "lastName", "d0"."first_name" as "firstName", "d0"."title" as "title", "d0"."title_of_courtesy" as "titleOfCourtesy", "d0"."birth_date" as "birthDate", "d0"."hire_date" as "hireDate", "d0"."address" as "address", "d0"."city" as "city", "d0"."postal_code" as "postalCode", "d0"."country" as "country", "d0"."home_phone" as "homePhone", "d0"."extension" as "extension", "d0"."notes" as "notes", "d0"."recipient_id" as "recipientId", "recipient"."r" as "recipient" from "employees" as "d0" left join lateral(select row_to_json("t".*) "r" from (select "d1"."id" as "id", "d1"."last_name" as "lastName", "d1"."first_name" as "firstName", "d1"."title" as "title", "d1"."title_of_courtesy" as "titleOfCourtesy", "d1"."birth_date" as "birthDate", "d1"."hire_date" as "hireDate", "d1"."address" as "address", "d1"."city" as "city", "d1"."postal_code" as "postalCode", "d1"."country" as "country", "d1"."home_phone" as "homePhone", "d1"."extension" as "extension", "d1"."notes" as "notes", "d1"."recipient_id" as "recipientId" from "employees" as "d1" where "d0"."recipient_id" = "d1"."id") as "t") as "recipient" on true where "d0"."id" = $1;
+-- name: EmployeeWithRecipient :many
+select "d0"."id" as "id", "d0"."last_name" as "lastName", "d0"."first_name" as "firstName", "d0"."title" as "title", "d0"."title_of_courtesy" as "titleOfCourtesy", "d0"."birth_date" as "birthDate", "d0"."hire_date" as "hireDate", "d0"."address" as "address", "d0"."city" as "city", "d0"."postal_code" as "postalCode", "d0"."country" as "country", "d0"."home_phone" as "homePhone", "d0"."extension" as "extension", "d0"."notes" as "notes", "d0"."recipient_id" as "recipientId", "recipient"."r" as "recipient" from "employees" as "d0" left join lateral(select row_to_json("t".*) "r" from (select "d1"."id" as "id", "d1"."last_name" as "lastName", "d1"."first_name" as "firstName", "d1"."title" as "title", "d1"."title_of_courtesy" as "titleOfCourtesy", to_char("d1"."birth_date", 'YYYY-MM-DD"T"HH24:MI:SS.MS"Z"') as "birthDate", to_char("d1"."hire_date", 'YYYY-MM-DD"T"HH24:MI:SS.MS"Z"') as "hireDate", "d1"."address" as "address", "d1"."city" as "city", "d1"."postal_code" as "postalCode", "d1"."country" as "country", "d1"."home_phone" as "homePhone", "d1"."extension" as "extension", "d1"."notes" as "notes", "d1"."recipient_id" as "recipientId" from "employees" as "d1" where "d0"."recipient_id" = "d1"."id") as "t") as "recipient" on true where "d0"."id" = $1;
 
 -- name: Suppliers :many
 select "d0"."id" as "id", "d0"."company_name" as "companyName", "d0"."contact_name" as "contactName", "d0"."contact_title" as "contactTitle", "d0"."address" as "address", "d0"."city" as "city", "d0"."region" as "region", "d0"."postal_code" as "postalCode", "d0"."country" as "country", "d0"."phone" as "phone" from "suppliers" as "d0" order by "d0"."id" asc limit $1 offset $2;
@@ -22,17 +25,20 @@ select "d0"."id" as "id", "d0"."company_name" as "companyName", "d0"."contact_na
 -- name: Products :many
 select "d0"."id" as "id", "d0"."name" as "name", "d0"."qt_per_unit" as "quantityPerUnit", "d0"."unit_price" as "unitPrice", "d0"."units_in_stock" as "unitsInStock", "d0"."units_on_order" as "unitsOnOrder", "d0"."reorder_level" as "reorderLevel", "d0"."discontinued" as "discontinued", "d0"."supplier_id" as "supplierId" from "products" as "d0" order by "d0"."id" asc limit $1 offset $2;
 
--- name: ProductWithSupplier :one
+-- name: ProductWithSupplier :many
 select "d0"."id" as "id", "d0"."name" as "name", "d0"."qt_per_unit" as "quantityPerUnit", "d0"."unit_price" as "unitPrice", "d0"."units_in_stock" as "unitsInStock", "d0"."units_on_order" as "unitsOnOrder", "d0"."reorder_level" as "reorderLevel", "d0"."discontinued" as "discontinued", "d0"."supplier_id" as "supplierId", "supplier"."r" as "supplier" from "products" as "d0" left join lateral(select row_to_json("t".*) "r" from (select "d1"."id" as "id", "d1"."company_name" as "companyName", "d1"."contact_name" as "contactName", "d1"."contact_title" as "contactTitle", "d1"."address" as "address", "d1"."city" as "city", "d1"."region" as "region", "d1"."postal_code" as "postalCode", "d1"."country" as "country", "d1"."phone" as "phone" from "suppliers" as "d1" where "d0"."supplier_id" = "d1"."id") as "t") as "supplier" on true where "d0"."id" = $1;
 
 -- name: SearchProduct :many
-select "d0"."id" as "id", "d0"."name" as "name", "d0"."qt_per_unit" as "quantityPerUnit", "d0"."unit_price" as "unitPrice", "d0"."units_in_stock" as "unitsInStock", "d0"."units_on_order" as "unitsOnOrder", "d0"."reorder_level" as "reorderLevel", "d0"."discontinued" as "discontinued", "d0"."supplier_id" as "supplierId" from "products" as "d0" where to_tsvector('english', "d0"."name") @@ to_tsquery('english', $1);
+select "d0"."id" as "id", "d0"."name" as "name", "d0"."qt_per_unit" as "quantityPerUnit", "d0"."unit_price" as "unitPrice", "d0"."units_in_stock" as "unitsInStock", "d0"."units_on_order" as "unitsOnOrder", "d0"."reorder_level" as "reorderLevel", "d0"."discontinued" as "discontinued", "d0"."supplier_id" as "supplierId" from "products" as "d0", (select case sqlc.arg(mode)::text when 'plain' then plainto_tsquery('english', sqlc.arg(query)::text) when 'phrase' then phraseto_tsquery('english', sqlc.arg(query)::text) when 'websearch' then websearch_to_tsquery('english', sqlc.arg(query)::text) else to_tsquery('english', sqlc.arg(query)::text) end as "query") as "q" where to_tsvector('english', "d0"."name") @@ "q"."query" order by ts_rank(to_tsvector('english', "d0"."name"), "q"."query") desc, "d0"."id" asc limit sqlc.arg('limit') offset sqlc.arg('offset');
+
+-- name: SearchProductHeadline :many
+select "d0"."id" as "id", "d0"."name" as "name", "d0"."qt_per_unit" as "quantityPerUnit", "d0"."unit_price" as "unitPrice", "d0"."units_in_stock" as "unitsInStock", "d0"."units_on_order" as "unitsOnOrder", "d0"."reorder_level" as "reorderLevel", "d0"."discontinued" as "discontinued", "d0"."supplier_id" as "supplierId", ts_headline('english', "d0"."name", "q"."query")::text as "headline" from "products" as "d0", (select case sqlc.arg(mode)::text when 'plain' then plainto_tsquery('english', sqlc.arg(query)::text) when 'phrase' then phraseto_tsquery('english', sqlc.arg(query)::text) when 'websearch' then websearch_to_tsquery('english', sqlc.arg(query)::text) else to_tsquery('english', sqlc.arg(query)::text) end as "query") as "q" where to_tsvector('english', "d0"."name") @@ "q"."query" order by ts_rank(to_tsvector('english', "d0"."name"), "q"."query") desc, "d0"."id" asc limit sqlc.arg('limit') offset sqlc.arg('offset');
 
 -- name: OrdersWithDetails :many
-select "orders"."id", "orders"."shipped_date", "orders"."ship_name", "orders"."ship_city", "orders"."ship_country", count("order_details"."product_id")::int, sum("order_details"."quantity")::int, sum("order_details"."quantity" * "order_details"."unit_price")::real from "orders" left join "order_details" on "order_details"."order_id" = "orders"."id" group by "orders"."id" order by "orders"."id" asc limit $1 offset $2;
+select "orders"."id" as "id", "orders"."shipped_date" as "shippedDate", "orders"."ship_name" as "shipName", "orders"."ship_city" as "shipCity", "orders"."ship_country" as "shipCountry", count("order_details"."product_id")::int as "productsCount", sum("order_details"."quantity")::int as "quantitySum", sum("order_details"."quantity" * "order_details"."unit_price")::real as "totalPrice" from "orders" left join "order_details" on "order_details"."order_id" = "orders"."id" group by "orders"."id" order by "orders"."id" asc limit $1 offset $2;
 
--- name: OrderWithDetails :one
-select "orders"."id", "orders"."shipped_date", "orders"."ship_name", "orders"."ship_city", "orders"."ship_country", count("order_details"."product_id")::int, sum("order_details"."quantity")::int, sum("order_details"."quantity" * "order_details"."unit_price")::real from "orders" left join "order_details" on "order_details"."order_id" = "orders"."id" where "orders"."id" = $1 group by "orders"."id" order by "orders"."id" asc;
+-- name: OrderWithDetails :many
+select "orders"."id" as "id", "orders"."shipped_date" as "shippedDate", "orders"."ship_name" as "shipName", "orders"."ship_city" as "shipCity", "orders"."ship_country" as "shipCountry", count("order_details"."product_id")::int as "productsCount", sum("order_details"."quantity")::int as "quantitySum", sum("order_details"."quantity" * "order_details"."unit_price")::real as "totalPrice" from "orders" left join "order_details" on "order_details"."order_id" = "orders"."id" where "orders"."id" = $1 group by "orders"."id" order by "orders"."id" asc;
 
--- name: OrderWithDetailsAndProducts :one
-select "d0"."id" as "id", "d0"."order_date" as "orderDate", "d0"."required_date" as "requiredDate", "d0"."shipped_date" as "shippedDate", "d0"."ship_via" as "shipVia", "d0"."freight" as "freight", "d0"."ship_name" as "shipName", "d0"."ship_city" as "shipCity", "d0"."ship_region" as "shipRegion", "d0"."ship_postal_code" as "shipPostalCode", "d0"."ship_country" as "shipCountry", "d0"."customer_id" as "customerId", "d0"."employee_id" as "employeeId", "details"."r" as "details" from "orders" as "d0" left join lateral(select coalesce(json_agg(row_to_json("t".*)), '[]') as "r" from (select "d1"."unit_price" as "unitPrice", "d1"."quantity" as "quantity", "d1"."discount" as "discount", "d1"."order_id" as "orderId", "d1"."product_id" as "productId", "product"."r" as "product" from "order_details" as "d1" left join lateral(select row_to_json("t".*) "r" from (select "d2"."id" as "id", "d2"."name" as "name", "d2"."qt_per_unit" as "quantityPerUnit", "d2"."unit_price" as "unitPrice", "d2"."units_in_stock" as "unitsInStock", "d2"."units_on_order" as "unitsOnOrder", "d2"."reorder_level" as "reorderLevel", "d2"."discontinued" as "discontinued", "d2"."supplier_id" as "supplierId" from "products" as "d2" where "d1"."product_id" = "d2"."id") as "t") as "product" on true where "d0"."id" = "d1"."order_id") as "t") as "details" on true where "d0"."id" = $1;
\ No newline at end of file
+-- name: OrderWithDetailsAndProducts :many
+select "d0"."id" as "id", "d0"."order_date" as "orderDate", "d0"."required_date" as "requiredDate", "d0"."shipped_date" as "shippedDate", "d0"."ship_via" as "shipVia", "d0"."freight" as "freight", "d0"."ship_name" as "shipName", "d0"."ship_city" as "shipCity", "d0"."ship_region" as "shipRegion", "d0"."ship_postal_code" as "shipPostalCode", "d0"."ship_country" as "shipCountry", "d0"."customer_id" as "customerId", "d0"."employee_id" as "employeeId", "details"."r"::json as "details" from "orders" as "d0" left join lateral(select coalesce(json_agg(row_to_json("t".*)), '[]') as "r" from (select "d1"."unit_price" as "unitPrice", "d1"."quantity" as "quantity", "d1"."discount" as "discount", "d1"."order_id" as "orderId", "d1"."product_id" as "productId", "product"."r" as "product" from "order_details" as "d1" left join lateral(select row_to_json("t".*) "r" from (select "d2"."id" as "id", "d2"."name" as "name", "d2"."qt_per_unit" as "quantityPerUnit", "d2"."unit_price" as "unitPrice", "d2"."units_in_stock" as "unitsInStock", "d2"."units_on_order" as "unitsOnOrder", "d2"."reorder_level" as "reorderLevel", "d2"."discontinued" as "discontinued", "d2"."supplier_id" as "supplierId" from "products" as "d2" where "d1"."product_id" = "d2"."id") as "t") as "product" on true where "d0"."id" = "d1"."order_id") as "t") as "details" on true where "d0"."id" = $1;
\ No newline at end of file
@@ -7,8 +7,6 @@ package db
 
 import (
 	"context"
-
-	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const customerById = `-- name: CustomerById :one
@@ -39,8 +37,8 @@ select "d0"."id" as "id", "d0"."company_name" as "companyName", "d0"."contact_na
 `
 
 type CustomersParams struct {
-	Limit  int32
-	Offset int32
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
 }
 
 func (q *Queries) Customers(ctx context.Context, arg CustomersParams) ([]Customer, error) {
@@ -49,7 +47,7 @@ func (q *Queries) Customers(ctx context.Context, arg CustomersParams) ([]Custome
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Customer
+	items := []Customer{}
 	for rows.Next() {
 		var i Customer
 		if err := rows.Scan(
@@ -75,51 +73,64 @@ func (q *Queries) Customers(ctx context.Context, arg CustomersParams) ([]Custome
 	return items, nil
 }
 
-const employeeWithRecipient = `-- name: EmployeeWithRecipient :one
-select "d0"."id" as "id", "d0"."last_name" as "lastName", "d0"."first_name" as "firstName", "d0"."title" as "title", "d0"."title_of_courtesy" as "titleOfCourtesy", "d0"."birth_date" as "birthDate", "d0"."hire_date" as "hireDate", "d0"."address" as "address", "d0"."city" as "city", "d0"."postal_code" as "postalCode", "d0"."country" as "country", "d0"."home_phone" as "homePhone", "d0"."extension" as "extension", "d0"."notes" as "notes", "d0"."recipient_id" as "recipientId", "recipient"."r" as "recipient" from "employees" as "d0" left join lateral(select row_to_json("t".*) "r" from (select "d1"."id" as "id", "d1"."last_name" as "lastName", "d1"."first_name" as "firstName", "d1"."title" as "title", "d1"."title_of_courtesy" as "titleOfCourtesy", "d1"."birth_date" as "birthDate", "d1"."hire_date" as "hireDate", "d1"."address" as "address", "d1"."city" as "city", "d1"."postal_code" as "postalCode", "d1"."country" as "country", "d1"."home_phone" as "homePhone", "d1"."extension" as "extension", "d1"."notes" as "notes", "d1"."recipient_id" as "recipientId" from "employees" as "d1" where "d0"."recipient_id" = "d1"."id") as "t") as "recipient" on true where "d0"."id" = $1
+const employeeWithRecipient = `-- name: EmployeeWithRecipient :many
+select "d0"."id" as "id", "d0"."last_name" as "lastName", "d0"."first_name" as "firstName", "d0"."title" as "title", "d0"."title_of_courtesy" as "titleOfCourtesy", "d0"."birth_date" as "birthDate", "d0"."hire_date" as "hireDate", "d0"."address" as "address", "d0"."city" as "city", "d0"."postal_code" as "postalCode", "d0"."country" as "country", "d0"."home_phone" as "homePhone", "d0"."extension" as "extension", "d0"."notes" as "notes", "d0"."recipient_id" as "recipientId", "recipient"."r" as "recipient" from "employees" as "d0" left join lateral(select row_to_json("t".*) "r" from (select "d1"."id" as "id", "d1"."last_name" as "lastName", "d1"."first_name" as "firstName", "d1"."title" as "title", "d1"."title_of_courtesy" as "titleOfCourtesy", to_char("d1"."birth_date", 'YYYY-MM-DD"T"HH24:MI:SS.MS"Z"') as "birthDate", to_char("d1"."hire_date", 'YYYY-MM-DD"T"HH24:MI:SS.MS"Z"') as "hireDate", "d1"."address" as "address", "d1"."city" as "city", "d1"."postal_code" as "postalCode", "d1"."country" as "country", "d1"."home_phone" as "homePhone", "d1"."extension" as "extension", "d1"."notes" as "notes", "d1"."recipient_id" as "recipientId" from "employees" as "d1" where "d0"."recipient_id" = "d1"."id") as "t") as "recipient" on true where "d0"."id" = $1
 `
 
 type EmployeeWithRecipientRow struct {
-	ID              int32
-	LastName        string
-	FirstName       pgtype.Text
-	Title           string
-	TitleOfCourtesy string
-	BirthDate       pgtype.Date
-	HireDate        pgtype.Date
-	Address         string
-	City            string
-	PostalCode      string
-	Country         string
-	HomePhone       string
-	Extension       int32
-	Notes           string
-	RecipientId     pgtype.Int4
-	Recipient       []byte
-}
-
-func (q *Queries) EmployeeWithRecipient(ctx context.Context, id int32) (EmployeeWithRecipientRow, error) {
-	row := q.db.QueryRow(ctx, employeeWithRecipient, id)
-	var i EmployeeWithRecipientRow
-	err := row.Scan(
-		&i.ID,
-		&i.LastName,
-		&i.FirstName,
-		&i.Title,
-		&i.TitleOfCourtesy,
-		&i.BirthDate,
-		&i.HireDate,
-		&i.Address,
-		&i.City,
-		&i.PostalCode,
-		&i.Country,
-		&i.HomePhone,
-		&i.Extension,
-		&i.Notes,
-		&i.RecipientId,
-		&i.Recipient,
-	)
-	return i, err
+	ID              int32   `json:"id"`
+	LastName        string  `json:"lastName"`
+	FirstName       *string `json:"firstName"`
+	Title           string  `json:"title"`
+	TitleOfCourtesy string  `json:"titleOfCourtesy"`
+	BirthDate       Date    `json:"birthDate"`
+	HireDate        Date    `json:"hireDate"`
+	Address         string  `json:"address"`
+	City            string  `json:"city"`
+	PostalCode      string  `json:"postalCode"`
+	Country         string  `json:"country"`
+	HomePhone       string  `json:"homePhone"`
+	Extension       int32   `json:"extension"`
+	Notes           string  `json:"notes"`
+	RecipientId     *int32  `json:"recipientId"`
+	Recipient       RawJSON `json:"recipient"`
+}
+
+func (q *Queries) EmployeeWithRecipient(ctx context.Context, id int32) ([]EmployeeWithRecipientRow, error) {
+	rows, err := q.db.Query(ctx, employeeWithRecipient, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []EmployeeWithRecipientRow{}
+	for rows.Next() {
+		var i EmployeeWithRecipientRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.LastName,
+			&i.FirstName,
+			&i.Title,
+			&i.TitleOfCourtesy,
+			&i.BirthDate,
+			&i.HireDate,
+			&i.Address,
+			&i.City,
+			&i.PostalCode,
+			&i.Country,
+			&i.HomePhone,
+			&i.Extension,
+			&i.Notes,
+			&i.RecipientId,
+			&i.Recipient,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const employees = `-- name: Employees :many
@@ -127,26 +138,26 @@ select "d0"."id" as "id", "d0"."last_name" as "lastName", "d0"."first_name" as "
 `
 
 type EmployeesParams struct {
-	Limit  int32
-	Offset int32
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
 }
 
 type EmployeesRow struct {
-	ID              int32
-	LastName        string
-	FirstName       pgtype.Text
-	Title           string
-	TitleOfCourtesy string
-	BirthDate       pgtype.Date
-	HireDate        pgtype.Date
-	Address         string
-	City            string
-	PostalCode      string
-	Country         string
-	HomePhone       string
-	Extension       int32
-	Notes           string
-	RecipientId     pgtype.Int4
+	ID              int32   `json:"id"`
+	LastName        string  `json:"lastName"`
+	FirstName       *string `json:"firstName"`
+	Title           string  `json:"title"`
+	TitleOfCourtesy string  `json:"titleOfCourtesy"`
+	BirthDate       Date    `json:"birthDate"`
+	HireDate        Date    `json:"hireDate"`
+	Address         string  `json:"address"`
+	City            string  `json:"city"`
+	PostalCode      string  `json:"postalCode"`
+	Country         string  `json:"country"`
+	HomePhone       string  `json:"homePhone"`
+	Extension       int32   `json:"extension"`
+	Notes           string  `json:"notes"`
+	RecipientId     *int32  `json:"recipientId"`
 }
 
 func (q *Queries) Employees(ctx context.Context, arg EmployeesParams) ([]EmployeesRow, error) {
@@ -155,7 +166,7 @@ func (q *Queries) Employees(ctx context.Context, arg EmployeesParams) ([]Employe
 		return nil, err
 	}
 	defer rows.Close()
-	var items []EmployeesRow
+	items := []EmployeesRow{}
 	for rows.Next() {
 		var i EmployeesRow
 		if err := rows.Scan(
@@ -185,98 +196,124 @@ func (q *Queries) Employees(ctx context.Context, arg EmployeesParams) ([]Employe
 	return items, nil
 }
 
-const orderWithDetails = `-- name: OrderWithDetails :one
-select "orders"."id", "orders"."shipped_date", "orders"."ship_name", "orders"."ship_city", "orders"."ship_country", count("order_details"."product_id")::int, sum("order_details"."quantity")::int, sum("order_details"."quantity" * "order_details"."unit_price")::real from "orders" left join "order_details" on "order_details"."order_id" = "orders"."id" where "orders"."id" = $1 group by "orders"."id" order by "orders"."id" asc
+const orderWithDetails = `-- name: OrderWithDetails :many
+select "orders"."id" as "id", "orders"."shipped_date" as "shippedDate", "orders"."ship_name" as "shipName", "orders"."ship_city" as "shipCity", "orders"."ship_country" as "shipCountry", count("order_details"."product_id")::int as "productsCount", sum("order_details"."quantity")::int as "quantitySum", sum("order_details"."quantity" * "order_details"."unit_price")::real as "totalPrice" from "orders" left join "order_details" on "order_details"."order_id" = "orders"."id" where "orders"."id" = $1 group by "orders"."id" order by "orders"."id" asc
 `
 
 type OrderWithDetailsRow struct {
-	ID          int32
-	ShippedDate pgtype.Date
-	ShipName    string
-	ShipCity    string
-	ShipCountry string
-	Column6     int32
-	Column7     int32
-	Column8     float32
-}
-
-func (q *Queries) OrderWithDetails(ctx context.Context, id int32) (OrderWithDetailsRow, error) {
-	row := q.db.QueryRow(ctx, orderWithDetails, id)
-	var i OrderWithDetailsRow
-	err := row.Scan(
-		&i.ID,
-		&i.ShippedDate,
-		&i.ShipName,
-		&i.ShipCity,
-		&i.ShipCountry,
-		&i.Column6,
-		&i.Column7,
-		&i.Column8,
-	)
-	return i, err
+	ID            int32   `json:"id"`
+	ShippedDate   Date    `json:"shippedDate"`
+	ShipName      string  `json:"shipName"`
+	ShipCity      string  `json:"shipCity"`
+	ShipCountry   string  `json:"shipCountry"`
+	ProductsCount int32   `json:"productsCount"`
+	QuantitySum   int32   `json:"quantitySum"`
+	TotalPrice    float32 `json:"totalPrice"`
+}
+
+func (q *Queries) OrderWithDetails(ctx context.Context, id int32) ([]OrderWithDetailsRow, error) {
+	rows, err := q.db.Query(ctx, orderWithDetails, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OrderWithDetailsRow{}
+	for rows.Next() {
+		var i OrderWithDetailsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ShippedDate,
+			&i.ShipName,
+			&i.ShipCity,
+			&i.ShipCountry,
+			&i.ProductsCount,
+			&i.QuantitySum,
+			&i.TotalPrice,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const orderWithDetailsAndProducts = `-- name: OrderWithDetailsAndProducts :one
-select "d0"."id" as "id", "d0"."order_date" as "orderDate", "d0"."required_date" as "requiredDate", "d0"."shipped_date" as "shippedDate", "d0"."ship_via" as "shipVia", "d0"."freight" as "freight", "d0"."ship_name" as "shipName", "d0"."ship_city" as "shipCity", "d0"."ship_region" as "shipRegion", "d0"."ship_postal_code" as "shipPostalCode", "d0"."ship_country" as "shipCountry", "d0"."customer_id" as "customerId", "d0"."employee_id" as "employeeId", "details"."r" as "details" from "orders" as "d0" left join lateral(select coalesce(json_agg(row_to_json("t".*)), '[]') as "r" from (select "d1"."unit_price" as "unitPrice", "d1"."quantity" as "quantity", "d1"."discount" as "discount", "d1"."order_id" as "orderId", "d1"."product_id" as "productId", "product"."r" as "product" from "order_details" as "d1" left join lateral(select row_to_json("t".*) "r" from (select "d2"."id" as "id", "d2"."name" as "name", "d2"."qt_per_unit" as "quantityPerUnit", "d2"."unit_price" as "unitPrice", "d2"."units_in_stock" as "unitsInStock", "d2"."units_on_order" as "unitsOnOrder", "d2"."reorder_level" as "reorderLevel", "d2"."discontinued" as "discontinued", "d2"."supplier_id" as "supplierId" from "products" as "d2" where "d1"."product_id" = "d2"."id") as "t") as "product" on true where "d0"."id" = "d1"."order_id") as "t") as "details" on true where "d0"."id" = $1
+const orderWithDetailsAndProducts = `-- name: OrderWithDetailsAndProducts :many
+select "d0"."id" as "id", "d0"."order_date" as "orderDate", "d0"."required_date" as "requiredDate", "d0"."shipped_date" as "shippedDate", "d0"."ship_via" as "shipVia", "d0"."freight" as "freight", "d0"."ship_name" as "shipName", "d0"."ship_city" as "shipCity", "d0"."ship_region" as "shipRegion", "d0"."ship_postal_code" as "shipPostalCode", "d0"."ship_country" as "shipCountry", "d0"."customer_id" as "customerId", "d0"."employee_id" as "employeeId", "details"."r"::json as "details" from "orders" as "d0" left join lateral(select coalesce(json_agg(row_to_json("t".*)), '[]') as "r" from (select "d1"."unit_price" as "unitPrice", "d1"."quantity" as "quantity", "d1"."discount" as "discount", "d1"."order_id" as "orderId", "d1"."product_id" as "productId", "product"."r" as "product" from "order_details" as "d1" left join lateral(select row_to_json("t".*) "r" from (select "d2"."id" as "id", "d2"."name" as "name", "d2"."qt_per_unit" as "quantityPerUnit", "d2"."unit_price" as "unitPrice", "d2"."units_in_stock" as "unitsInStock", "d2"."units_on_order" as "unitsOnOrder", "d2"."reorder_level" as "reorderLevel", "d2"."discontinued" as "discontinued", "d2"."supplier_id" as "supplierId" from "products" as "d2" where "d1"."product_id" = "d2"."id") as "t") as "product" on true where "d0"."id" = "d1"."order_id") as "t") as "details" on true where "d0"."id" = $1
 `
 
 type OrderWithDetailsAndProductsRow struct {
-	ID             int32
-	OrderDate      pgtype.Date
-	RequiredDate   pgtype.Date
-	ShippedDate    pgtype.Date
-	ShipVia        int32
-	Freight        float64
-	ShipName       string
-	ShipCity       string
-	ShipRegion     pgtype.Text
-	ShipPostalCode pgtype.Text
-	ShipCountry    string
-	CustomerId     int32
-	EmployeeId     int32
-	Details        interface{}
-}
-
-func (q *Queries) OrderWithDetailsAndProducts(ctx context.Context, id int32) (OrderWithDetailsAndProductsRow, error) {
-	row := q.db.QueryRow(ctx, orderWithDetailsAndProducts, id)
-	var i OrderWithDetailsAndProductsRow
-	err := row.Scan(
-		&i.ID,
-		&i.OrderDate,
-		&i.RequiredDate,
-		&i.ShippedDate,
-		&i.ShipVia,
-		&i.Freight,
-		&i.ShipName,
-		&i.ShipCity,
-		&i.ShipRegion,
-		&i.ShipPostalCode,
-		&i.ShipCountry,
-		&i.CustomerId,
-		&i.EmployeeId,
-		&i.Details,
-	)
-	return i, err
+	ID             int32   `json:"id"`
+	OrderDate      Date    `json:"orderDate"`
+	RequiredDate   Date    `json:"requiredDate"`
+	ShippedDate    Date    `json:"shippedDate"`
+	ShipVia        int32   `json:"shipVia"`
+	Freight        float64 `json:"freight"`
+	ShipName       string  `json:"shipName"`
+	ShipCity       string  `json:"shipCity"`
+	ShipRegion     *string `json:"shipRegion"`
+	ShipPostalCode *string `json:"shipPostalCode"`
+	ShipCountry    string  `json:"shipCountry"`
+	CustomerId     int32   `json:"customerId"`
+	EmployeeId     int32   `json:"employeeId"`
+	Details        RawJSON `json:"details"`
+}
+
+func (q *Queries) OrderWithDetailsAndProducts(ctx context.Context, id int32) ([]OrderWithDetailsAndProductsRow, error) {
+	rows, err := q.db.Query(ctx, orderWithDetailsAndProducts, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OrderWithDetailsAndProductsRow{}
+	for rows.Next() {
+		var i OrderWithDetailsAndProductsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrderDate,
+			&i.RequiredDate,
+			&i.ShippedDate,
+			&i.ShipVia,
+			&i.Freight,
+			&i.ShipName,
+			&i.ShipCity,
+			&i.ShipRegion,
+			&i.ShipPostalCode,
+			&i.ShipCountry,
+			&i.CustomerId,
+			&i.EmployeeId,
+			&i.Details,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const ordersWithDetails = `-- name: OrdersWithDetails :many
-select "orders"."id", "orders"."shipped_date", "orders"."ship_name", "orders"."ship_city", "orders"."ship_country", count("order_details"."product_id")::int, sum("order_details"."quantity")::int, sum("order_details"."quantity" * "order_details"."unit_price")::real from "orders" left join "order_details" on "order_details"."order_id" = "orders"."id" group by "orders"."id" order by "orders"."id" asc limit $1 offset $2
+select "orders"."id" as "id", "orders"."shipped_date" as "shippedDate", "orders"."ship_name" as "shipName", "orders"."ship_city" as "shipCity", "orders"."ship_country" as "shipCountry", count("order_details"."product_id")::int as "productsCount", sum("order_details"."quantity")::int as "quantitySum", sum("order_details"."quantity" * "order_details"."unit_price")::real as "totalPrice" from "orders" left join "order_details" on "order_details"."order_id" = "orders"."id" group by "orders"."id" order by "orders"."id" asc limit $1 offset $2
 `
 
 type OrdersWithDetailsParams struct {
-	Limit  int32
-	Offset int32
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
 }
 
 type OrdersWithDetailsRow struct {
-	ID          int32
-	ShippedDate pgtype.Date
-	ShipName    string
-	ShipCity    string
-	ShipCountry string
-	Column6     int32
-	Column7     int32
-	Column8     float32
+	ID            int32   `json:"id"`
+	ShippedDate   Date    `json:"shippedDate"`
+	ShipName      string  `json:"shipName"`
+	ShipCity      string  `json:"shipCity"`
+	ShipCountry   string  `json:"shipCountry"`
+	ProductsCount int32   `json:"productsCount"`
+	QuantitySum   int32   `json:"quantitySum"`
+	TotalPrice    float32 `json:"totalPrice"`
 }
 
 func (q *Queries) OrdersWithDetails(ctx context.Context, arg OrdersWithDetailsParams) ([]OrdersWithDetailsRow, error) {
@@ -285,7 +322,7 @@ func (q *Queries) OrdersWithDetails(ctx context.Context, arg OrdersWithDetailsPa
 		return nil, err
 	}
 	defer rows.Close()
-	var items []OrdersWithDetailsRow
+	items := []OrdersWithDetailsRow{}
 	for rows.Next() {
 		var i OrdersWithDetailsRow
 		if err := rows.Scan(
@@ -294,9 +331,9 @@ func (q *Queries) OrdersWithDetails(ctx context.Context, arg OrdersWithDetailsPa
 			&i.ShipName,
 			&i.ShipCity,
 			&i.ShipCountry,
-			&i.Column6,
-			&i.Column7,
-			&i.Column8,
+			&i.ProductsCount,
+			&i.QuantitySum,
+			&i.TotalPrice,
 		); err != nil {
 			return nil, err
 		}
@@ -308,39 +345,52 @@ func (q *Queries) OrdersWithDetails(ctx context.Context, arg OrdersWithDetailsPa
 	return items, nil
 }
 
-const productWithSupplier = `-- name: ProductWithSupplier :one
+const productWithSupplier = `-- name: ProductWithSupplier :many
 select "d0"."id" as "id", "d0"."name" as "name", "d0"."qt_per_unit" as "quantityPerUnit", "d0"."unit_price" as "unitPrice", "d0"."units_in_stock" as "unitsInStock", "d0"."units_on_order" as "unitsOnOrder", "d0"."reorder_level" as "reorderLevel", "d0"."discontinued" as "discontinued", "d0"."supplier_id" as "supplierId", "supplier"."r" as "supplier" from "products" as "d0" left join lateral(select row_to_json("t".*) "r" from (select "d1"."id" as "id", "d1"."company_name" as "companyName", "d1"."contact_name" as "contactName", "d1"."contact_title" as "contactTitle", "d1"."address" as "address", "d1"."city" as "city", "d1"."region" as "region", "d1"."postal_code" as "postalCode", "d1"."country" as "country", "d1"."phone" as "phone" from "suppliers" as "d1" where "d0"."supplier_id" = "d1"."id") as "t") as "supplier" on true where "d0"."id" = $1
 `
 
 type ProductWithSupplierRow struct {
-	ID              int32
-	Name            string
-	QuantityPerUnit string
-	UnitPrice       float64
-	UnitsInStock    int32
-	UnitsOnOrder    int32
-	ReorderLevel    int32
-	Discontinued    int32
-	SupplierId      int32
-	Supplier        []byte
-}
-
-func (q *Queries) ProductWithSupplier(ctx context.Context, id int32) (ProductWithSupplierRow, error) {
-	row := q.db.QueryRow(ctx, productWithSupplier, id)
-	var i ProductWithSupplierRow
-	err := row.Scan(
-		&i.ID,
-		&i.Name,
-		&i.QuantityPerUnit,
-		&i.UnitPrice,
-		&i.UnitsInStock,
-		&i.UnitsOnOrder,
-		&i.ReorderLevel,
-		&i.Discontinued,
-		&i.SupplierId,
-		&i.Supplier,
-	)
-	return i, err
+	ID              int32   `json:"id"`
+	Name            string  `json:"name"`
+	QuantityPerUnit string  `json:"quantityPerUnit"`
+	UnitPrice       float64 `json:"unitPrice"`
+	UnitsInStock    int32   `json:"unitsInStock"`
+	UnitsOnOrder    int32   `json:"unitsOnOrder"`
+	ReorderLevel    int32   `json:"reorderLevel"`
+	Discontinued    int32   `json:"discontinued"`
+	SupplierId      int32   `json:"supplierId"`
+	Supplier        RawJSON `json:"supplier"`
+}
+
+func (q *Queries) ProductWithSupplier(ctx context.Context, id int32) ([]ProductWithSupplierRow, error) {
+	rows, err := q.db.Query(ctx, productWithSupplier, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ProductWithSupplierRow{}
+	for rows.Next() {
+		var i ProductWithSupplierRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.QuantityPerUnit,
+			&i.UnitPrice,
+			&i.UnitsInStock,
+			&i.UnitsOnOrder,
+			&i.ReorderLevel,
+			&i.Discontinued,
+			&i.SupplierId,
+			&i.Supplier,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const products = `-- name: Products :many
@@ -348,20 +398,20 @@ select "d0"."id" as "id", "d0"."name" as "name", "d0"."qt_per_unit" as "quantity
 `
 
 type ProductsParams struct {
-	Limit  int32
-	Offset int32
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
 }
 
 type ProductsRow struct {
-	ID              int32
-	Name            string
-	QuantityPerUnit string
-	UnitPrice       float64
-	UnitsInStock    int32
-	UnitsOnOrder    int32
-	ReorderLevel    int32
-	Discontinued    int32
-	SupplierId      int32
+	ID              int32   `json:"id"`
+	Name            string  `json:"name"`
+	QuantityPerUnit string  `json:"quantityPerUnit"`
+	UnitPrice       float64 `json:"unitPrice"`
+	UnitsInStock    int32   `json:"unitsInStock"`
+	UnitsOnOrder    int32   `json:"unitsOnOrder"`
+	ReorderLevel    int32   `json:"reorderLevel"`
+	Discontinued    int32   `json:"discontinued"`
+	SupplierId      int32   `json:"supplierId"`
 }
 
 func (q *Queries) Products(ctx context.Context, arg ProductsParams) ([]ProductsRow, error) {
@@ -370,7 +420,7 @@ func (q *Queries) Products(ctx context.Context, arg ProductsParams) ([]ProductsR
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ProductsRow
+	items := []ProductsRow{}
 	for rows.Next() {
 		var i ProductsRow
 		if err := rows.Scan(
@@ -395,16 +445,28 @@ func (q *Queries) Products(ctx context.Context, arg ProductsParams) ([]ProductsR
 }
 
 const searchCustomer = `-- name: SearchCustomer :many
-select "d0"."id" as "id", "d0"."company_name" as "companyName", "d0"."contact_name" as "contactName", "d0"."contact_title" as "contactTitle", "d0"."address" as "address", "d0"."city" as "city", "d0"."postal_code" as "postalCode", "d0"."region" as "region", "d0"."country" as "country", "d0"."phone" as "phone", "d0"."fax" as "fax" from "customers" as "d0" where to_tsvector('english', "d0"."company_name") @@ to_tsquery('english', $1)
+select "d0"."id" as "id", "d0"."company_name" as "companyName", "d0"."contact_name" as "contactName", "d0"."contact_title" as "contactTitle", "d0"."address" as "address", "d0"."city" as "city", "d0"."postal_code" as "postalCode", "d0"."region" as "region", "d0"."country" as "country", "d0"."phone" as "phone", "d0"."fax" as "fax" from "customers" as "d0", (select case $1::text when 'plain' then plainto_tsquery('english', $2::text) when 'phrase' then phraseto_tsquery('english', $2::text) when 'websearch' then websearch_to_tsquery('english', $2::text) else to_tsquery('english', $2::text) end as "query") as "q" where to_tsvector('english', "d0"."company_name") @@ "q"."query" order by ts_rank(to_tsvector('english', "d0"."company_name"), "q"."query") desc, "d0"."id" asc limit $4 offset $3
 `
 
-func (q *Queries) SearchCustomer(ctx context.Context, toTsquery string) ([]Customer, error) {
-	rows, err := q.db.Query(ctx, searchCustomer, toTsquery)
+type SearchCustomerParams struct {
+	Mode   string `json:"mode"`
+	Query  string `json:"query"`
+	Offset int32  `json:"offset"`
+	Limit  int32  `json:"limit"`
+}
+
+func (q *Queries) SearchCustomer(ctx context.Context, arg SearchCustomerParams) ([]Customer, error) {
+	rows, err := q.db.Query(ctx, searchCustomer,
+		arg.Mode,
+		arg.Query,
+		arg.Offset,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Customer
+	items := []Customer{}
 	for rows.Next() {
 		var i Customer
 		if err := rows.Scan(
@@ -430,29 +492,105 @@ func (q *Queries) SearchCustomer(ctx context.Context, toTsquery string) ([]Custo
 	return items, nil
 }
 
+const searchCustomerHeadline = `-- name: SearchCustomerHeadline :many
+select "d0"."id" as "id", "d0"."company_name" as "companyName", "d0"."contact_name" as "contactName", "d0"."contact_title" as "contactTitle", "d0"."address" as "address", "d0"."city" as "city", "d0"."postal_code" as "postalCode", "d0"."region" as "region", "d0"."country" as "country", "d0"."phone" as "phone", "d0"."fax" as "fax", ts_headline('english', "d0"."company_name", "q"."query")::text as "headline" from "customers" as "d0", (select case $1::text when 'plain' then plainto_tsquery('english', $2::text) when 'phrase' then phraseto_tsquery('english', $2::text) when 'websearch' then websearch_to_tsquery('english', $2::text) else to_tsquery('english', $2::text) end as "query") as "q" where to_tsvector('english', "d0"."company_name") @@ "q"."query" order by ts_rank(to_tsvector('english', "d0"."company_name"), "q"."query") desc, "d0"."id" asc limit $4 offset $3
+`
+
+type SearchCustomerHeadlineParams struct {
+	Mode   string `json:"mode"`
+	Query  string `json:"query"`
+	Offset int32  `json:"offset"`
+	Limit  int32  `json:"limit"`
+}
+
+type SearchCustomerHeadlineRow struct {
+	ID           int32   `json:"id"`
+	CompanyName  string  `json:"companyName"`
+	ContactName  string  `json:"contactName"`
+	ContactTitle string  `json:"contactTitle"`
+	Address      string  `json:"address"`
+	City         string  `json:"city"`
+	PostalCode   *string `json:"postalCode"`
+	Region       *string `json:"region"`
+	Country      string  `json:"country"`
+	Phone        string  `json:"phone"`
+	Fax          *string `json:"fax"`
+	Headline     string  `json:"headline"`
+}
+
+func (q *Queries) SearchCustomerHeadline(ctx context.Context, arg SearchCustomerHeadlineParams) ([]SearchCustomerHeadlineRow, error) {
+	rows, err := q.db.Query(ctx, searchCustomerHeadline,
+		arg.Mode,
+		arg.Query,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchCustomerHeadlineRow{}
+	for rows.Next() {
+		var i SearchCustomerHeadlineRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CompanyName,
+			&i.ContactName,
+			&i.ContactTitle,
+			&i.Address,
+			&i.City,
+			&i.PostalCode,
+			&i.Region,
+			&i.Country,
+			&i.Phone,
+			&i.Fax,
+			&i.Headline,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const searchProduct = `-- name: SearchProduct :many
-select "d0"."id" as "id", "d0"."name" as "name", "d0"."qt_per_unit" as "quantityPerUnit", "d0"."unit_price" as "unitPrice", "d0"."units_in_stock" as "unitsInStock", "d0"."units_on_order" as "unitsOnOrder", "d0"."reorder_level" as "reorderLevel", "d0"."discontinued" as "discontinued", "d0"."supplier_id" as "supplierId" from "products" as "d0" where to_tsvector('english', "d0"."name") @@ to_tsquery('english', $1)
+select "d0"."id" as "id", "d0"."name" as "name", "d0"."qt_per_unit" as "quantityPerUnit", "d0"."unit_price" as "unitPrice", "d0"."units_in_stock" as "unitsInStock", "d0"."units_on_order" as "unitsOnOrder", "d0"."reorder_level" as "reorderLevel", "d0"."discontinued" as "discontinued", "d0"."supplier_id" as "supplierId" from "products" as "d0", (select case $1::text when 'plain' then plainto_tsquery('english', $2::text) when 'phrase' then phraseto_tsquery('english', $2::text) when 'websearch' then websearch_to_tsquery('english', $2::text) else to_tsquery('english', $2::text) end as "query") as "q" where to_tsvector('english', "d0"."name") @@ "q"."query" order by ts_rank(to_tsvector('english', "d0"."name"), "q"."query") desc, "d0"."id" asc limit $4 offset $3
 `
 
+type SearchProductParams struct {
+	Mode   string `json:"mode"`
+	Query  string `json:"query"`
+	Offset int32  `json:"offset"`
+	Limit  int32  `json:"limit"`
+}
+
 type SearchProductRow struct {
-	ID              int32
-	Name            string
-	QuantityPerUnit string
-	UnitPrice       float64
-	UnitsInStock    int32
-	UnitsOnOrder    int32
-	ReorderLevel    int32
-	Discontinued    int32
-	SupplierId      int32
-}
-
-func (q *Queries) SearchProduct(ctx context.Context, toTsquery string) ([]SearchProductRow, error) {
-	rows, err := q.db.Query(ctx, searchProduct, toTsquery)
+	ID              int32   `json:"id"`
+	Name            string  `json:"name"`
+	QuantityPerUnit string  `json:"quantityPerUnit"`
+	UnitPrice       float64 `json:"unitPrice"`
+	UnitsInStock    int32   `json:"unitsInStock"`
+	UnitsOnOrder    int32   `json:"unitsOnOrder"`
+	ReorderLevel    int32   `json:"reorderLevel"`
+	Discontinued    int32   `json:"discontinued"`
+	SupplierId      int32   `json:"supplierId"`
+}
+
+func (q *Queries) SearchProduct(ctx context.Context, arg SearchProductParams) ([]SearchProductRow, error) {
+	rows, err := q.db.Query(ctx, searchProduct,
+		arg.Mode,
+		arg.Query,
+		arg.Offset,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []SearchProductRow
+	items := []SearchProductRow{}
 	for rows.Next() {
 		var i SearchProductRow
 		if err := rows.Scan(
@@ -476,6 +614,66 @@ func (q *Queries) SearchProduct(ctx context.Context, toTsquery string) ([]Search
 	return items, nil
 }
 
+const searchProductHeadline = `-- name: SearchProductHeadline :many
+select "d0"."id" as "id", "d0"."name" as "name", "d0"."qt_per_unit" as "quantityPerUnit", "d0"."unit_price" as "unitPrice", "d0"."units_in_stock" as "unitsInStock", "d0"."units_on_order" as "unitsOnOrder", "d0"."reorder_level" as "reorderLevel", "d0"."discontinued" as "discontinued", "d0"."supplier_id" as "supplierId", ts_headline('english', "d0"."name", "q"."query")::text as "headline" from "products" as "d0", (select case $1::text when 'plain' then plainto_tsquery('english', $2::text) when 'phrase' then phraseto_tsquery('english', $2::text) when 'websearch' then websearch_to_tsquery('english', $2::text) else to_tsquery('english', $2::text) end as "query") as "q" where to_tsvector('english', "d0"."name") @@ "q"."query" order by ts_rank(to_tsvector('english', "d0"."name"), "q"."query") desc, "d0"."id" asc limit $4 offset $3
+`
+
+type SearchProductHeadlineParams struct {
+	Mode   string `json:"mode"`
+	Query  string `json:"query"`
+	Offset int32  `json:"offset"`
+	Limit  int32  `json:"limit"`
+}
+
+type SearchProductHeadlineRow struct {
+	ID              int32   `json:"id"`
+	Name            string  `json:"name"`
+	QuantityPerUnit string  `json:"quantityPerUnit"`
+	UnitPrice       float64 `json:"unitPrice"`
+	UnitsInStock    int32   `json:"unitsInStock"`
+	UnitsOnOrder    int32   `json:"unitsOnOrder"`
+	ReorderLevel    int32   `json:"reorderLevel"`
+	Discontinued    int32   `json:"discontinued"`
+	SupplierId      int32   `json:"supplierId"`
+	Headline        string  `json:"headline"`
+}
+
+func (q *Queries) SearchProductHeadline(ctx context.Context, arg SearchProductHeadlineParams) ([]SearchProductHeadlineRow, error) {
+	rows, err := q.db.Query(ctx, searchProductHeadline,
+		arg.Mode,
+		arg.Query,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchProductHeadlineRow{}
+	for rows.Next() {
+		var i SearchProductHeadlineRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.QuantityPerUnit,
+			&i.UnitPrice,
+			&i.UnitsInStock,
+			&i.UnitsOnOrder,
+			&i.ReorderLevel,
+			&i.Discontinued,
+			&i.SupplierId,
+			&i.Headline,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const supplierById = `-- name: SupplierById :one
 select "d0"."id" as "id", "d0"."company_name" as "companyName", "d0"."contact_name" as "contactName", "d0"."contact_title" as "contactTitle", "d0"."address" as "address", "d0"."city" as "city", "d0"."region" as "region", "d0"."postal_code" as "postalCode", "d0"."country" as "country", "d0"."phone" as "phone" from "suppliers" as "d0" where "d0"."id" = $1
 `
@@ -503,8 +701,8 @@ select "d0"."id" as "id", "d0"."company_name" as "companyName", "d0"."contact_na
 `
 
 type SuppliersParams struct {
-	Limit  int32
-	Offset int32
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
 }
 
 func (q *Queries) Suppliers(ctx context.Context, arg SuppliersParams) ([]Supplier, error) {
@@ -513,7 +711,7 @@ func (q *Queries) Suppliers(ctx context.Context, arg SuppliersParams) ([]Supplie
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Supplier
+	items := []Supplier{}
 	for rows.Next() {
 		var i Supplier
 		if err := rows.Scan(
@@ -0,0 +1,80 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Pinger is implemented by stores that hold connections, for readiness to
+// check that the database still answers.
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// Check is one line of a readiness report.
+type Check struct {
+	Name   string `json:"name"`
+	OK     bool   `json:"ok"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// migrations is the number of entries in drizzle/meta/_journal.json, which
+// src/seed.ts applies before it seeds.
+const migrations = 2
+
+// searchIndexes are the GIN indexes of drizzle/0001_concerned_mother_askani.sql
+// that keep the search routes off sequential scans.
+var searchIndexes = []string{"customers_company_name_idx", "products_name_idx"}
+
+// CheckSchema connects to databaseUrl once and reports whether the drizzle
+// migrations have been applied and the search indexes exist.
+func CheckSchema(ctx context.Context, databaseUrl string) []Check {
+	conn, err := pgx.Connect(ctx, databaseUrl)
+	if err != nil {
+		return []Check{{Name: "schema", Detail: err.Error()}}
+	}
+	defer conn.Close(context.Background())
+
+	checks := []Check{checkMigrations(ctx, conn)}
+
+	rows, err := conn.Query(ctx, `select c.relname from pg_class c join pg_am a on a.oid = c.relam where a.amname = 'gin' and c.relname = any($1)`, searchIndexes)
+	if err != nil {
+		return append(checks, Check{Name: "indexes", Detail: err.Error()})
+	}
+
+	found, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return append(checks, Check{Name: "indexes", Detail: err.Error()})
+	}
+
+	var missing []string
+	for _, name := range searchIndexes {
+		if !slices.Contains(found, name) {
+			missing = append(missing, name)
+		}
+	}
+
+	check := Check{Name: "indexes", OK: len(missing) == 0}
+	if !check.OK {
+		check.Detail = "missing " + strings.Join(missing, ", ")
+	}
+
+	return append(checks, check)
+}
+
+func checkMigrations(ctx context.Context, conn *pgx.Conn) Check {
+	var applied int
+	if err := conn.QueryRow(ctx, `select count(*) from drizzle.__drizzle_migrations`).Scan(&applied); err != nil {
+		return Check{Name: "migrations", Detail: err.Error()}
+	}
+
+	return Check{
+		Name:   "migrations",
+		OK:     applied >= migrations,
+		Detail: fmt.Sprintf("%d of %d applied", applied, migrations),
+	}
+}
@@ -0,0 +1,104 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/bytedance/sonic"
+)
+
+// RelationMode selects how the row_to_json relation columns reach the client.
+type RelationMode string
+
+const (
+	// RelationsRaw streams the json Postgres built straight into the response.
+	RelationsRaw RelationMode = "raw"
+	// RelationsTyped decodes relations into Go structs and encodes them again,
+	// which is what an application that touches the nested rows would pay.
+	RelationsTyped RelationMode = "typed"
+)
+
+func ParseRelationMode(s string) (RelationMode, error) {
+	switch mode := RelationMode(s); mode {
+	case RelationsRaw, RelationsTyped:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown relation mode %q", s)
+	}
+}
+
+func (m RelationMode) MarshalText() ([]byte, error) {
+	return []byte(m), nil
+}
+
+func (m *RelationMode) UnmarshalText(text []byte) (err error) {
+	*m, err = ParseRelationMode(string(text))
+	return err
+}
+
+// The typed rows embed the sqlc row and shadow its RawJSON column with a
+// decoded field of the same json name, so both modes produce the same keys.
+
+type EmployeeWithRecipient struct {
+	EmployeeWithRecipientRow
+	Recipient *EmployeesRow `json:"recipient"`
+}
+
+type ProductWithSupplier struct {
+	ProductWithSupplierRow
+	Supplier *Supplier `json:"supplier"`
+}
+
+type OrderDetailWithProduct struct {
+	OrderDetail
+	Product *ProductsRow `json:"product"`
+}
+
+type OrderWithDetailsAndProducts struct {
+	OrderWithDetailsAndProductsRow
+	Details []OrderDetailWithProduct `json:"details"`
+}
+
+func DecodeEmployeeWithRecipient(rows []EmployeeWithRecipientRow) ([]EmployeeWithRecipient, error) {
+	items := make([]EmployeeWithRecipient, len(rows))
+	for i, row := range rows {
+		items[i].EmployeeWithRecipientRow = row
+		if err := decodeRelation(row.Recipient, &items[i].Recipient); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+func DecodeProductWithSupplier(rows []ProductWithSupplierRow) ([]ProductWithSupplier, error) {
+	items := make([]ProductWithSupplier, len(rows))
+	for i, row := range rows {
+		items[i].ProductWithSupplierRow = row
+		if err := decodeRelation(row.Supplier, &items[i].Supplier); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+func DecodeOrderWithDetailsAndProducts(rows []OrderWithDetailsAndProductsRow) ([]OrderWithDetailsAndProducts, error) {
+	items := make([]OrderWithDetailsAndProducts, len(rows))
+	for i, row := range rows {
+		items[i].OrderWithDetailsAndProductsRow = row
+		if err := decodeRelation(row.Details, &items[i].Details); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+func decodeRelation(raw RawJSON, v any) error {
+	if raw == nil {
+		return nil
+	}
+
+	if err := sonic.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("decode relation: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// MaxSearchWords bounds how many words a prefix search may combine.
+const MaxSearchWords = 16
+
+// SearchMode picks the Postgres function that turns the user's term into a
+// tsquery.
+type SearchMode string
+
+const (
+	// SearchPrefix matches every word as a prefix with to_tsquery, which is
+	// what the Drizzle servers do with `${term}:*`.
+	SearchPrefix    SearchMode = "prefix"
+	SearchPlain     SearchMode = "plain"
+	SearchPhrase    SearchMode = "phrase"
+	SearchWebsearch SearchMode = "websearch"
+)
+
+// SearchOp joins the words of a prefix search.
+type SearchOp string
+
+const (
+	SearchAnd SearchOp = "and"
+	SearchOr  SearchOp = "or"
+)
+
+var ErrEmptySearch = errors.New("search term has no words")
+
+// Search is a validated full-text search request.
+type Search struct {
+	Mode SearchMode
+	Op   SearchOp
+	// Term is the raw user input, handed as-is to the plain, phrase and
+	// websearch functions, which never fail to parse.
+	Term string
+	// Words are the tokens of Term, lower-cased, used by prefix mode.
+	Words []string
+}
+
+func NewSearch(term string, mode SearchMode, op SearchOp) (Search, error) {
+	switch mode {
+	case SearchPrefix, SearchPlain, SearchPhrase, SearchWebsearch:
+	default:
+		return Search{}, fmt.Errorf("unknown search mode %q", mode)
+	}
+
+	switch op {
+	case SearchAnd, SearchOr:
+	default:
+		return Search{}, fmt.Errorf("unknown search operator %q", op)
+	}
+
+	words := Tokenize(term)
+	if len(words) == 0 {
+		return Search{}, ErrEmptySearch
+	}
+
+	if mode == SearchPrefix && len(words) > MaxSearchWords {
+		return Search{}, fmt.Errorf("search term has more than %d words", MaxSearchWords)
+	}
+
+	return Search{Mode: mode, Op: op, Term: term, Words: words}, nil
+}
+
+// Query is the text argument for the tsquery function selected by Mode.
+func (s Search) Query() string {
+	if s.Mode != SearchPrefix {
+		return s.Term
+	}
+
+	sep := " & "
+	if s.Op == SearchOr {
+		sep = " | "
+	}
+
+	var b strings.Builder
+	for i, w := range s.Words {
+		if i > 0 {
+			b.WriteString(sep)
+		}
+
+		writeLexeme(&b, w)
+		b.WriteString(":*")
+	}
+
+	return b.String()
+}
+
+// TSQueryFunc names the Postgres function that parses a query of the given
+// mode, for backends that assemble the search SQL themselves. Like the CASE in
+// queries.sql, anything unknown falls back to to_tsquery.
+func TSQueryFunc(mode string) string {
+	switch SearchMode(mode) {
+	case SearchPlain:
+		return "plainto_tsquery"
+	case SearchPhrase:
+		return "phraseto_tsquery"
+	case SearchWebsearch:
+		return "websearch_to_tsquery"
+	default:
+		return "to_tsquery"
+	}
+}
+
+// Tokenize splits on anything that is not a letter or digit, the same
+// boundaries the default text search parser uses for plain words, so tsquery
+// operators, quotes and parentheses never reach to_tsquery.
+func Tokenize(term string) []string {
+	return strings.FieldsFunc(strings.ToLower(term), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// writeLexeme writes w as a quoted tsquery lexeme. w comes from Tokenize,
+// which only yields letters and digits, so it never holds the quote or the
+// backslash a lexeme would need escaped.
+func writeLexeme(b *strings.Builder, w string) {
+	b.WriteByte('\'')
+	b.WriteString(w)
+	b.WriteByte('\'')
+}
+
+// SearchParams are the arguments of the search methods of Store, Highlighter
+// and Encoder. They carry the Search itself, so the stores that match words
+// on their own read its Words and Op, and the SQL ones pass Postgres its Mode
+// and Query.
+type SearchParams struct {
+	Search
+	Limit  int32
+	Offset int32
+}
+
+// Searches gives Queries the search methods of Store and Highlighter, which
+// run the sqlc search queries with the mode and the tsquery text of the
+// Search.
+type Searches struct {
+	*Queries
+}
+
+func (q Searches) SearchCustomer(ctx context.Context, arg SearchParams) ([]Customer, error) {
+	return q.Queries.SearchCustomer(ctx, SearchCustomerParams{Mode: string(arg.Mode), Query: arg.Query(), Offset: arg.Offset, Limit: arg.Limit})
+}
+
+func (q Searches) SearchCustomerHeadline(ctx context.Context, arg SearchParams) ([]SearchCustomerHeadlineRow, error) {
+	return q.Queries.SearchCustomerHeadline(ctx, SearchCustomerHeadlineParams{Mode: string(arg.Mode), Query: arg.Query(), Offset: arg.Offset, Limit: arg.Limit})
+}
+
+func (q Searches) SearchProduct(ctx context.Context, arg SearchParams) ([]SearchProductRow, error) {
+	return q.Queries.SearchProduct(ctx, SearchProductParams{Mode: string(arg.Mode), Query: arg.Query(), Offset: arg.Offset, Limit: arg.Limit})
+}
+
+func (q Searches) SearchProductHeadline(ctx context.Context, arg SearchParams) ([]SearchProductHeadlineRow, error) {
+	return q.Queries.SearchProductHeadline(ctx, SearchProductHeadlineParams{Mode: string(arg.Mode), Query: arg.Query(), Offset: arg.Offset, Limit: arg.Limit})
+}
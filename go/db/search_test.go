@@ -0,0 +1,127 @@
+package db
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		term string
+		want []string
+	}{
+		{"ve", []string{"ve"}},
+		{"Chai Tea", []string{"chai", "tea"}},
+		{"  leading and trailing  ", []string{"leading", "and", "trailing"}},
+		{"a&b|c!d", []string{"a", "b", "c", "d"}},
+		{"o'reilly", []string{"o", "reilly"}},
+		{`back\slash`, []string{"back", "slash"}},
+		{"(x):* <-> y", []string{"x", "y"}},
+		{"Île-de-France 2024", []string{"île", "de", "france", "2024"}},
+		{"&|!():*'", nil},
+		{"", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.term, func(t *testing.T) {
+			if got := Tokenize(tt.term); !slices.Equal(got, tt.want) {
+				t.Errorf("Tokenize(%q) = %q, want %q", tt.term, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestTokenizeQuoting checks what writeLexeme relies on to skip escaping:
+// no word Tokenize yields holds a quote or a backslash.
+func TestTokenizeQuoting(t *testing.T) {
+	terms := []string{
+		`o'reilly`,
+		`'; drop table customers; --`,
+		`a\b\\c`,
+		`\'\''`,
+		"’s ʼ ＇ \u0027",
+		"tab\tand\nnewline",
+	}
+
+	for _, term := range terms {
+		for _, w := range Tokenize(term) {
+			if strings.ContainsAny(w, `'\`) {
+				t.Errorf("Tokenize(%q) yields %q", term, w)
+			}
+
+			var b strings.Builder
+			writeLexeme(&b, w)
+			if got := b.String(); got != "'"+w+"'" {
+				t.Errorf("writeLexeme(%q) = %s", w, got)
+			}
+		}
+	}
+}
+
+func TestSearchQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		term    string
+		mode    SearchMode
+		op      SearchOp
+		want    string
+		wantErr bool
+	}{
+		{"prefix", "ve", SearchPrefix, SearchAnd, `'ve':*`, false},
+		{"prefix and", "chai tea", SearchPrefix, SearchAnd, `'chai':* & 'tea':*`, false},
+		{"prefix or", "chai tea", SearchPrefix, SearchOr, `'chai':* | 'tea':*`, false},
+		{"operators dropped", "a & !b | (c", SearchPrefix, SearchAnd, `'a':* & 'b':* & 'c':*`, false},
+		{"quotes dropped", `it's "x"`, SearchPrefix, SearchAnd, `'it':* & 's':* & 'x':*`, false},
+		{"plain passes the term", "a & !b", SearchPlain, SearchAnd, "a & !b", false},
+		{"phrase passes the term", "chai tea", SearchPhrase, SearchAnd, "chai tea", false},
+		{"websearch passes the term", `"chai tea" -green`, SearchWebsearch, SearchAnd, `"chai tea" -green`, false},
+		{"no words", "&|!", SearchPrefix, SearchAnd, "", true},
+		{"no words in plain", "  ", SearchPlain, SearchAnd, "", true},
+		{"unknown mode", "ve", "regex", SearchAnd, "", true},
+		{"unknown op", "ve", SearchPrefix, "xor", "", true},
+		{"too many words", strings.Repeat("w ", MaxSearchWords+1), SearchPrefix, SearchAnd, "", true},
+		{"many words in plain", strings.Repeat("w ", MaxSearchWords+1), SearchPlain, SearchAnd, strings.Repeat("w ", MaxSearchWords+1), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := NewSearch(tt.term, tt.mode, tt.op)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("NewSearch(%q) = %+v, want an error", tt.term, s)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewSearch(%q): %v", tt.term, err)
+			}
+
+			if got := s.Query(); got != tt.want {
+				t.Errorf("Query() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := NewSearch("!", SearchPrefix, SearchAnd); !errors.Is(err, ErrEmptySearch) {
+		t.Errorf("NewSearch(%q) error = %v, want ErrEmptySearch", "!", err)
+	}
+}
+
+func TestTSQueryFunc(t *testing.T) {
+	tests := map[string]string{
+		"prefix":    "to_tsquery",
+		"plain":     "plainto_tsquery",
+		"phrase":    "phraseto_tsquery",
+		"websearch": "websearch_to_tsquery",
+		"":          "to_tsquery",
+		"unknown":   "to_tsquery",
+	}
+
+	for mode, want := range tests {
+		if got := TSQueryFunc(mode); got != want {
+			t.Errorf("TSQueryFunc(%q) = %q, want %q", mode, got, want)
+		}
+	}
+}
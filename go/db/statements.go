@@ -0,0 +1,23 @@
+package db
+
+// The statements of queries.sql as sqlc generated them, for the backends that
+// run the same SQL without Queries. Every column is aliased to the JSON tag of
+// its row field, which sqlx maps by, and the parameters are numbered as in the
+// Params structs, so the search queries take the offset before the limit.
+const (
+	CustomersSQL                   = customers
+	CustomerByIdSQL                = customerById
+	SearchCustomerSQL              = searchCustomer
+	SearchCustomerHeadlineSQL      = searchCustomerHeadline
+	EmployeesSQL                   = employees
+	EmployeeWithRecipientSQL       = employeeWithRecipient
+	SuppliersSQL                   = suppliers
+	SupplierByIdSQL                = supplierById
+	ProductsSQL                    = products
+	ProductWithSupplierSQL         = productWithSupplier
+	SearchProductSQL               = searchProduct
+	SearchProductHeadlineSQL       = searchProductHeadline
+	OrdersWithDetailsSQL           = ordersWithDetails
+	OrderWithDetailsSQL            = orderWithDetails
+	OrderWithDetailsAndProductsSQL = orderWithDetailsAndProducts
+)
@@ -0,0 +1,70 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Store is everything the HTTP server needs from a data access library. The
+// methods mirror the sqlc queries, but for the searches, which take the Search
+// in SearchParams. *Client satisfies it with Searches, and every other backend
+// returns the same row types to keep the JSON identical.
+type Store interface {
+	Customers(ctx context.Context, arg CustomersParams) ([]Customer, error)
+	CustomerById(ctx context.Context, id int32) (Customer, error)
+	SearchCustomer(ctx context.Context, arg SearchParams) ([]Customer, error)
+	Employees(ctx context.Context, arg EmployeesParams) ([]EmployeesRow, error)
+	EmployeeWithRecipient(ctx context.Context, id int32) ([]EmployeeWithRecipientRow, error)
+	Suppliers(ctx context.Context, arg SuppliersParams) ([]Supplier, error)
+	SupplierById(ctx context.Context, id int32) (Supplier, error)
+	Products(ctx context.Context, arg ProductsParams) ([]ProductsRow, error)
+	ProductWithSupplier(ctx context.Context, id int32) ([]ProductWithSupplierRow, error)
+	SearchProduct(ctx context.Context, arg SearchParams) ([]SearchProductRow, error)
+	OrdersWithDetails(ctx context.Context, arg OrdersWithDetailsParams) ([]OrdersWithDetailsRow, error)
+	OrderWithDetails(ctx context.Context, id int32) ([]OrderWithDetailsRow, error)
+	OrderWithDetailsAndProducts(ctx context.Context, id int32) ([]OrderWithDetailsAndProductsRow, error)
+	Close()
+}
+
+// Highlighter is implemented by stores that can return ts_headline snippets
+// for the search routes.
+type Highlighter interface {
+	SearchCustomerHeadline(ctx context.Context, arg SearchParams) ([]SearchCustomerHeadlineRow, error)
+	SearchProductHeadline(ctx context.Context, arg SearchParams) ([]SearchProductHeadlineRow, error)
+}
+
+// Encoder is implemented by stores that write the response JSON themselves,
+// straight from the values on the wire. Each method appends to buf the same
+// JSON the matching Store or Highlighter method's rows marshal to, and returns
+// the extended buffer, even on error.
+type Encoder interface {
+	AppendCustomers(ctx context.Context, buf []byte, arg CustomersParams) ([]byte, error)
+	AppendCustomerById(ctx context.Context, buf []byte, id int32) ([]byte, error)
+	AppendSearchCustomer(ctx context.Context, buf []byte, arg SearchParams) ([]byte, error)
+	AppendSearchCustomerHeadline(ctx context.Context, buf []byte, arg SearchParams) ([]byte, error)
+	AppendEmployees(ctx context.Context, buf []byte, arg EmployeesParams) ([]byte, error)
+	AppendEmployeeWithRecipient(ctx context.Context, buf []byte, id int32) ([]byte, error)
+	AppendSuppliers(ctx context.Context, buf []byte, arg SuppliersParams) ([]byte, error)
+	AppendSupplierById(ctx context.Context, buf []byte, id int32) ([]byte, error)
+	AppendProducts(ctx context.Context, buf []byte, arg ProductsParams) ([]byte, error)
+	AppendProductWithSupplier(ctx context.Context, buf []byte, id int32) ([]byte, error)
+	AppendSearchProduct(ctx context.Context, buf []byte, arg SearchParams) ([]byte, error)
+	AppendSearchProductHeadline(ctx context.Context, buf []byte, arg SearchParams) ([]byte, error)
+	AppendOrdersWithDetails(ctx context.Context, buf []byte, arg OrdersWithDetailsParams) ([]byte, error)
+	AppendOrderWithDetails(ctx context.Context, buf []byte, id int32) ([]byte, error)
+	AppendOrderWithDetailsAndProducts(ctx context.Context, buf []byte, id int32) ([]byte, error)
+}
+
+// PoolStatter is implemented by stores over a pgxpool, whose counters the
+// server logs when it shuts down.
+type PoolStatter interface {
+	Stat() *pgxpool.Stat
+}
+
+var (
+	_ Store       = (*Client)(nil)
+	_ Highlighter = (*Client)(nil)
+	_ PoolStatter = (*Client)(nil)
+	_ Pinger      = (*Client)(nil)
+)
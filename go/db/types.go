@@ -0,0 +1,100 @@
+package db
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// jsDateLayout matches Date.prototype.toJSON, which is how Drizzle serializes
+// `date` columns declared with `{ mode: 'date' }`.
+const jsDateLayout = "2006-01-02T15:04:05.000Z"
+
+// Date is a pgtype.Date that serializes the same way the Drizzle servers do:
+// an ISO timestamp at UTC midnight, or null for SQL NULL.
+type Date struct {
+	pgtype.Date
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	return d.AppendJSON(make([]byte, 0, len(jsDateLayout)+2)), nil
+}
+
+// AppendJSON appends the JSON encoding of d to b, for encoders that write
+// responses without going through MarshalJSON.
+func (d Date) AppendJSON(b []byte) []byte {
+	if !d.Valid || d.InfinityModifier != pgtype.Finite {
+		return append(b, "null"...)
+	}
+
+	b = append(b, '"')
+	b = d.Time.UTC().AppendFormat(b, jsDateLayout)
+	return append(b, '"')
+}
+
+// UnmarshalJSON reads the layout MarshalJSON writes, which the lateral
+// row_to_json queries also produce, so typed mode can decode relations. Plain
+// dates are still accepted.
+func (d *Date) UnmarshalJSON(b []byte) error {
+	if len(b) == len(jsDateLayout)+2 && b[0] == '"' {
+		t, err := time.ParseInLocation(jsDateLayout, string(b[1:len(b)-1]), time.UTC)
+		if err != nil {
+			return err
+		}
+
+		*d = Date{pgtype.Date{Time: t, Valid: true}}
+		return nil
+	}
+
+	return d.Date.UnmarshalJSON(b)
+}
+
+// DateOf converts the time.Time an ORM scans a date column into, with nil for
+// SQL NULL.
+func DateOf(t *time.Time) Date {
+	if t == nil {
+		return Date{}
+	}
+
+	return Date{pgtype.Date{Time: *t, Valid: true}}
+}
+
+// RawJSON holds a json column exactly as Postgres sent it. It satisfies
+// pgtype.BytesScanner, so the JSON codec registered in NewDatabase hands over
+// the wire bytes without decoding them, and the response encoder writes them
+// back out verbatim.
+type RawJSON []byte
+
+func (j *RawJSON) ScanBytes(src []byte) error {
+	if src == nil {
+		*j = nil
+		return nil
+	}
+
+	*j = append((*j)[:0], src...)
+	return nil
+}
+
+func (j *RawJSON) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		*j = nil
+	case []byte:
+		*j = append((*j)[:0], src...)
+	case string:
+		*j = append((*j)[:0], src...)
+	default:
+		return fmt.Errorf("cannot scan %T into RawJSON", src)
+	}
+
+	return nil
+}
+
+func (j RawJSON) MarshalJSON() ([]byte, error) {
+	if j == nil {
+		return []byte("null"), nil
+	}
+
+	return j, nil
+}
@@ -0,0 +1,63 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// queries lists every statement of queries.sql, for warm-up to prepare.
+var queries = []string{
+	customers,
+	customerById,
+	searchCustomer,
+	searchCustomerHeadline,
+	employees,
+	employeeWithRecipient,
+	suppliers,
+	supplierById,
+	products,
+	productWithSupplier,
+	searchProduct,
+	searchProductHeadline,
+	ordersWithDetails,
+	orderWithDetails,
+	orderWithDetailsAndProducts,
+}
+
+// prepareQueries prepares every query on conn under its own SQL as the name,
+// which pgx looks up before its statement cache, so no request pays for the
+// parse on a fresh connection.
+func prepareQueries(ctx context.Context, conn *pgx.Conn) error {
+	for _, sql := range queries {
+		if _, err := conn.Prepare(ctx, sql, sql); err != nil {
+			return fmt.Errorf("unable to prepare queries: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// waitForMinConns waits until the pool has finished opening the MinConns
+// connections it starts dialing in the background when it is created.
+func waitForMinConns(ctx context.Context, pool *pgxpool.Pool, minConns int32) error {
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		stat := pool.Stat()
+		open := stat.IdleConns() + stat.AcquiredConns()
+		if open >= minConns {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("warm-up opened %d of %d connections: %w", open, minConns, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,997 @@
+// Code generated by ent, DO NOT EDIT.
+
+package employee
+
+import (
+	"perf-drizzle/go/entdb/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int32) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int32) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int32) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int32) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int32) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int32) predicate.Employee {
+	return predicate.Employee(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int32) predicate.Employee {
+	return predicate.Employee(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int32) predicate.Employee {
+	return predicate.Employee(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int32) predicate.Employee {
+	return predicate.Employee(sql.FieldLTE(FieldID, id))
+}
+
+// LastName applies equality check predicate on the "last_name" field. It's identical to LastNameEQ.
+func LastName(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldLastName, v))
+}
+
+// FirstName applies equality check predicate on the "first_name" field. It's identical to FirstNameEQ.
+func FirstName(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldFirstName, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleOfCourtesy applies equality check predicate on the "title_of_courtesy" field. It's identical to TitleOfCourtesyEQ.
+func TitleOfCourtesy(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldTitleOfCourtesy, v))
+}
+
+// BirthDate applies equality check predicate on the "birth_date" field. It's identical to BirthDateEQ.
+func BirthDate(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldBirthDate, v))
+}
+
+// HireDate applies equality check predicate on the "hire_date" field. It's identical to HireDateEQ.
+func HireDate(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldHireDate, v))
+}
+
+// Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
+func Address(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldAddress, v))
+}
+
+// City applies equality check predicate on the "city" field. It's identical to CityEQ.
+func City(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldCity, v))
+}
+
+// PostalCode applies equality check predicate on the "postal_code" field. It's identical to PostalCodeEQ.
+func PostalCode(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldPostalCode, v))
+}
+
+// Country applies equality check predicate on the "country" field. It's identical to CountryEQ.
+func Country(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldCountry, v))
+}
+
+// HomePhone applies equality check predicate on the "home_phone" field. It's identical to HomePhoneEQ.
+func HomePhone(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldHomePhone, v))
+}
+
+// Extension applies equality check predicate on the "extension" field. It's identical to ExtensionEQ.
+func Extension(v int32) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldExtension, v))
+}
+
+// Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
+func Notes(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldNotes, v))
+}
+
+// RecipientID applies equality check predicate on the "recipient_id" field. It's identical to RecipientIDEQ.
+func RecipientID(v int32) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldRecipientID, v))
+}
+
+// LastNameEQ applies the EQ predicate on the "last_name" field.
+func LastNameEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldLastName, v))
+}
+
+// LastNameNEQ applies the NEQ predicate on the "last_name" field.
+func LastNameNEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldLastName, v))
+}
+
+// LastNameIn applies the In predicate on the "last_name" field.
+func LastNameIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldLastName, vs...))
+}
+
+// LastNameNotIn applies the NotIn predicate on the "last_name" field.
+func LastNameNotIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldLastName, vs...))
+}
+
+// LastNameGT applies the GT predicate on the "last_name" field.
+func LastNameGT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGT(FieldLastName, v))
+}
+
+// LastNameGTE applies the GTE predicate on the "last_name" field.
+func LastNameGTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGTE(FieldLastName, v))
+}
+
+// LastNameLT applies the LT predicate on the "last_name" field.
+func LastNameLT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLT(FieldLastName, v))
+}
+
+// LastNameLTE applies the LTE predicate on the "last_name" field.
+func LastNameLTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLTE(FieldLastName, v))
+}
+
+// LastNameContains applies the Contains predicate on the "last_name" field.
+func LastNameContains(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContains(FieldLastName, v))
+}
+
+// LastNameHasPrefix applies the HasPrefix predicate on the "last_name" field.
+func LastNameHasPrefix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasPrefix(FieldLastName, v))
+}
+
+// LastNameHasSuffix applies the HasSuffix predicate on the "last_name" field.
+func LastNameHasSuffix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasSuffix(FieldLastName, v))
+}
+
+// LastNameEqualFold applies the EqualFold predicate on the "last_name" field.
+func LastNameEqualFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEqualFold(FieldLastName, v))
+}
+
+// LastNameContainsFold applies the ContainsFold predicate on the "last_name" field.
+func LastNameContainsFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContainsFold(FieldLastName, v))
+}
+
+// FirstNameEQ applies the EQ predicate on the "first_name" field.
+func FirstNameEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldFirstName, v))
+}
+
+// FirstNameNEQ applies the NEQ predicate on the "first_name" field.
+func FirstNameNEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldFirstName, v))
+}
+
+// FirstNameIn applies the In predicate on the "first_name" field.
+func FirstNameIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldFirstName, vs...))
+}
+
+// FirstNameNotIn applies the NotIn predicate on the "first_name" field.
+func FirstNameNotIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldFirstName, vs...))
+}
+
+// FirstNameGT applies the GT predicate on the "first_name" field.
+func FirstNameGT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGT(FieldFirstName, v))
+}
+
+// FirstNameGTE applies the GTE predicate on the "first_name" field.
+func FirstNameGTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGTE(FieldFirstName, v))
+}
+
+// FirstNameLT applies the LT predicate on the "first_name" field.
+func FirstNameLT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLT(FieldFirstName, v))
+}
+
+// FirstNameLTE applies the LTE predicate on the "first_name" field.
+func FirstNameLTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLTE(FieldFirstName, v))
+}
+
+// FirstNameContains applies the Contains predicate on the "first_name" field.
+func FirstNameContains(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContains(FieldFirstName, v))
+}
+
+// FirstNameHasPrefix applies the HasPrefix predicate on the "first_name" field.
+func FirstNameHasPrefix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasPrefix(FieldFirstName, v))
+}
+
+// FirstNameHasSuffix applies the HasSuffix predicate on the "first_name" field.
+func FirstNameHasSuffix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasSuffix(FieldFirstName, v))
+}
+
+// FirstNameIsNil applies the IsNil predicate on the "first_name" field.
+func FirstNameIsNil() predicate.Employee {
+	return predicate.Employee(sql.FieldIsNull(FieldFirstName))
+}
+
+// FirstNameNotNil applies the NotNil predicate on the "first_name" field.
+func FirstNameNotNil() predicate.Employee {
+	return predicate.Employee(sql.FieldNotNull(FieldFirstName))
+}
+
+// FirstNameEqualFold applies the EqualFold predicate on the "first_name" field.
+func FirstNameEqualFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEqualFold(FieldFirstName, v))
+}
+
+// FirstNameContainsFold applies the ContainsFold predicate on the "first_name" field.
+func FirstNameContainsFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContainsFold(FieldFirstName, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// TitleOfCourtesyEQ applies the EQ predicate on the "title_of_courtesy" field.
+func TitleOfCourtesyEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldTitleOfCourtesy, v))
+}
+
+// TitleOfCourtesyNEQ applies the NEQ predicate on the "title_of_courtesy" field.
+func TitleOfCourtesyNEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldTitleOfCourtesy, v))
+}
+
+// TitleOfCourtesyIn applies the In predicate on the "title_of_courtesy" field.
+func TitleOfCourtesyIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldTitleOfCourtesy, vs...))
+}
+
+// TitleOfCourtesyNotIn applies the NotIn predicate on the "title_of_courtesy" field.
+func TitleOfCourtesyNotIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldTitleOfCourtesy, vs...))
+}
+
+// TitleOfCourtesyGT applies the GT predicate on the "title_of_courtesy" field.
+func TitleOfCourtesyGT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGT(FieldTitleOfCourtesy, v))
+}
+
+// TitleOfCourtesyGTE applies the GTE predicate on the "title_of_courtesy" field.
+func TitleOfCourtesyGTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGTE(FieldTitleOfCourtesy, v))
+}
+
+// TitleOfCourtesyLT applies the LT predicate on the "title_of_courtesy" field.
+func TitleOfCourtesyLT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLT(FieldTitleOfCourtesy, v))
+}
+
+// TitleOfCourtesyLTE applies the LTE predicate on the "title_of_courtesy" field.
+func TitleOfCourtesyLTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLTE(FieldTitleOfCourtesy, v))
+}
+
+// TitleOfCourtesyContains applies the Contains predicate on the "title_of_courtesy" field.
+func TitleOfCourtesyContains(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContains(FieldTitleOfCourtesy, v))
+}
+
+// TitleOfCourtesyHasPrefix applies the HasPrefix predicate on the "title_of_courtesy" field.
+func TitleOfCourtesyHasPrefix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasPrefix(FieldTitleOfCourtesy, v))
+}
+
+// TitleOfCourtesyHasSuffix applies the HasSuffix predicate on the "title_of_courtesy" field.
+func TitleOfCourtesyHasSuffix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasSuffix(FieldTitleOfCourtesy, v))
+}
+
+// TitleOfCourtesyEqualFold applies the EqualFold predicate on the "title_of_courtesy" field.
+func TitleOfCourtesyEqualFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEqualFold(FieldTitleOfCourtesy, v))
+}
+
+// TitleOfCourtesyContainsFold applies the ContainsFold predicate on the "title_of_courtesy" field.
+func TitleOfCourtesyContainsFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContainsFold(FieldTitleOfCourtesy, v))
+}
+
+// BirthDateEQ applies the EQ predicate on the "birth_date" field.
+func BirthDateEQ(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldBirthDate, v))
+}
+
+// BirthDateNEQ applies the NEQ predicate on the "birth_date" field.
+func BirthDateNEQ(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldBirthDate, v))
+}
+
+// BirthDateIn applies the In predicate on the "birth_date" field.
+func BirthDateIn(vs ...time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldBirthDate, vs...))
+}
+
+// BirthDateNotIn applies the NotIn predicate on the "birth_date" field.
+func BirthDateNotIn(vs ...time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldBirthDate, vs...))
+}
+
+// BirthDateGT applies the GT predicate on the "birth_date" field.
+func BirthDateGT(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldGT(FieldBirthDate, v))
+}
+
+// BirthDateGTE applies the GTE predicate on the "birth_date" field.
+func BirthDateGTE(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldGTE(FieldBirthDate, v))
+}
+
+// BirthDateLT applies the LT predicate on the "birth_date" field.
+func BirthDateLT(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldLT(FieldBirthDate, v))
+}
+
+// BirthDateLTE applies the LTE predicate on the "birth_date" field.
+func BirthDateLTE(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldLTE(FieldBirthDate, v))
+}
+
+// HireDateEQ applies the EQ predicate on the "hire_date" field.
+func HireDateEQ(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldHireDate, v))
+}
+
+// HireDateNEQ applies the NEQ predicate on the "hire_date" field.
+func HireDateNEQ(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldHireDate, v))
+}
+
+// HireDateIn applies the In predicate on the "hire_date" field.
+func HireDateIn(vs ...time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldHireDate, vs...))
+}
+
+// HireDateNotIn applies the NotIn predicate on the "hire_date" field.
+func HireDateNotIn(vs ...time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldHireDate, vs...))
+}
+
+// HireDateGT applies the GT predicate on the "hire_date" field.
+func HireDateGT(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldGT(FieldHireDate, v))
+}
+
+// HireDateGTE applies the GTE predicate on the "hire_date" field.
+func HireDateGTE(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldGTE(FieldHireDate, v))
+}
+
+// HireDateLT applies the LT predicate on the "hire_date" field.
+func HireDateLT(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldLT(FieldHireDate, v))
+}
+
+// HireDateLTE applies the LTE predicate on the "hire_date" field.
+func HireDateLTE(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldLTE(FieldHireDate, v))
+}
+
+// AddressEQ applies the EQ predicate on the "address" field.
+func AddressEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldAddress, v))
+}
+
+// AddressNEQ applies the NEQ predicate on the "address" field.
+func AddressNEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldAddress, v))
+}
+
+// AddressIn applies the In predicate on the "address" field.
+func AddressIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldAddress, vs...))
+}
+
+// AddressNotIn applies the NotIn predicate on the "address" field.
+func AddressNotIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldAddress, vs...))
+}
+
+// AddressGT applies the GT predicate on the "address" field.
+func AddressGT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGT(FieldAddress, v))
+}
+
+// AddressGTE applies the GTE predicate on the "address" field.
+func AddressGTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGTE(FieldAddress, v))
+}
+
+// AddressLT applies the LT predicate on the "address" field.
+func AddressLT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLT(FieldAddress, v))
+}
+
+// AddressLTE applies the LTE predicate on the "address" field.
+func AddressLTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLTE(FieldAddress, v))
+}
+
+// AddressContains applies the Contains predicate on the "address" field.
+func AddressContains(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContains(FieldAddress, v))
+}
+
+// AddressHasPrefix applies the HasPrefix predicate on the "address" field.
+func AddressHasPrefix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasPrefix(FieldAddress, v))
+}
+
+// AddressHasSuffix applies the HasSuffix predicate on the "address" field.
+func AddressHasSuffix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasSuffix(FieldAddress, v))
+}
+
+// AddressEqualFold applies the EqualFold predicate on the "address" field.
+func AddressEqualFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEqualFold(FieldAddress, v))
+}
+
+// AddressContainsFold applies the ContainsFold predicate on the "address" field.
+func AddressContainsFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContainsFold(FieldAddress, v))
+}
+
+// CityEQ applies the EQ predicate on the "city" field.
+func CityEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldCity, v))
+}
+
+// CityNEQ applies the NEQ predicate on the "city" field.
+func CityNEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldCity, v))
+}
+
+// CityIn applies the In predicate on the "city" field.
+func CityIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldCity, vs...))
+}
+
+// CityNotIn applies the NotIn predicate on the "city" field.
+func CityNotIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldCity, vs...))
+}
+
+// CityGT applies the GT predicate on the "city" field.
+func CityGT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGT(FieldCity, v))
+}
+
+// CityGTE applies the GTE predicate on the "city" field.
+func CityGTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGTE(FieldCity, v))
+}
+
+// CityLT applies the LT predicate on the "city" field.
+func CityLT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLT(FieldCity, v))
+}
+
+// CityLTE applies the LTE predicate on the "city" field.
+func CityLTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLTE(FieldCity, v))
+}
+
+// CityContains applies the Contains predicate on the "city" field.
+func CityContains(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContains(FieldCity, v))
+}
+
+// CityHasPrefix applies the HasPrefix predicate on the "city" field.
+func CityHasPrefix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasPrefix(FieldCity, v))
+}
+
+// CityHasSuffix applies the HasSuffix predicate on the "city" field.
+func CityHasSuffix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasSuffix(FieldCity, v))
+}
+
+// CityEqualFold applies the EqualFold predicate on the "city" field.
+func CityEqualFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEqualFold(FieldCity, v))
+}
+
+// CityContainsFold applies the ContainsFold predicate on the "city" field.
+func CityContainsFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContainsFold(FieldCity, v))
+}
+
+// PostalCodeEQ applies the EQ predicate on the "postal_code" field.
+func PostalCodeEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldPostalCode, v))
+}
+
+// PostalCodeNEQ applies the NEQ predicate on the "postal_code" field.
+func PostalCodeNEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldPostalCode, v))
+}
+
+// PostalCodeIn applies the In predicate on the "postal_code" field.
+func PostalCodeIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldPostalCode, vs...))
+}
+
+// PostalCodeNotIn applies the NotIn predicate on the "postal_code" field.
+func PostalCodeNotIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldPostalCode, vs...))
+}
+
+// PostalCodeGT applies the GT predicate on the "postal_code" field.
+func PostalCodeGT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGT(FieldPostalCode, v))
+}
+
+// PostalCodeGTE applies the GTE predicate on the "postal_code" field.
+func PostalCodeGTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGTE(FieldPostalCode, v))
+}
+
+// PostalCodeLT applies the LT predicate on the "postal_code" field.
+func PostalCodeLT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLT(FieldPostalCode, v))
+}
+
+// PostalCodeLTE applies the LTE predicate on the "postal_code" field.
+func PostalCodeLTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLTE(FieldPostalCode, v))
+}
+
+// PostalCodeContains applies the Contains predicate on the "postal_code" field.
+func PostalCodeContains(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContains(FieldPostalCode, v))
+}
+
+// PostalCodeHasPrefix applies the HasPrefix predicate on the "postal_code" field.
+func PostalCodeHasPrefix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasPrefix(FieldPostalCode, v))
+}
+
+// PostalCodeHasSuffix applies the HasSuffix predicate on the "postal_code" field.
+func PostalCodeHasSuffix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasSuffix(FieldPostalCode, v))
+}
+
+// PostalCodeEqualFold applies the EqualFold predicate on the "postal_code" field.
+func PostalCodeEqualFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEqualFold(FieldPostalCode, v))
+}
+
+// PostalCodeContainsFold applies the ContainsFold predicate on the "postal_code" field.
+func PostalCodeContainsFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContainsFold(FieldPostalCode, v))
+}
+
+// CountryEQ applies the EQ predicate on the "country" field.
+func CountryEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldCountry, v))
+}
+
+// CountryNEQ applies the NEQ predicate on the "country" field.
+func CountryNEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldCountry, v))
+}
+
+// CountryIn applies the In predicate on the "country" field.
+func CountryIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldCountry, vs...))
+}
+
+// CountryNotIn applies the NotIn predicate on the "country" field.
+func CountryNotIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldCountry, vs...))
+}
+
+// CountryGT applies the GT predicate on the "country" field.
+func CountryGT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGT(FieldCountry, v))
+}
+
+// CountryGTE applies the GTE predicate on the "country" field.
+func CountryGTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGTE(FieldCountry, v))
+}
+
+// CountryLT applies the LT predicate on the "country" field.
+func CountryLT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLT(FieldCountry, v))
+}
+
+// CountryLTE applies the LTE predicate on the "country" field.
+func CountryLTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLTE(FieldCountry, v))
+}
+
+// CountryContains applies the Contains predicate on the "country" field.
+func CountryContains(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContains(FieldCountry, v))
+}
+
+// CountryHasPrefix applies the HasPrefix predicate on the "country" field.
+func CountryHasPrefix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasPrefix(FieldCountry, v))
+}
+
+// CountryHasSuffix applies the HasSuffix predicate on the "country" field.
+func CountryHasSuffix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasSuffix(FieldCountry, v))
+}
+
+// CountryEqualFold applies the EqualFold predicate on the "country" field.
+func CountryEqualFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEqualFold(FieldCountry, v))
+}
+
+// CountryContainsFold applies the ContainsFold predicate on the "country" field.
+func CountryContainsFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContainsFold(FieldCountry, v))
+}
+
+// HomePhoneEQ applies the EQ predicate on the "home_phone" field.
+func HomePhoneEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldHomePhone, v))
+}
+
+// HomePhoneNEQ applies the NEQ predicate on the "home_phone" field.
+func HomePhoneNEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldHomePhone, v))
+}
+
+// HomePhoneIn applies the In predicate on the "home_phone" field.
+func HomePhoneIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldHomePhone, vs...))
+}
+
+// HomePhoneNotIn applies the NotIn predicate on the "home_phone" field.
+func HomePhoneNotIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldHomePhone, vs...))
+}
+
+// HomePhoneGT applies the GT predicate on the "home_phone" field.
+func HomePhoneGT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGT(FieldHomePhone, v))
+}
+
+// HomePhoneGTE applies the GTE predicate on the "home_phone" field.
+func HomePhoneGTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGTE(FieldHomePhone, v))
+}
+
+// HomePhoneLT applies the LT predicate on the "home_phone" field.
+func HomePhoneLT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLT(FieldHomePhone, v))
+}
+
+// HomePhoneLTE applies the LTE predicate on the "home_phone" field.
+func HomePhoneLTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLTE(FieldHomePhone, v))
+}
+
+// HomePhoneContains applies the Contains predicate on the "home_phone" field.
+func HomePhoneContains(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContains(FieldHomePhone, v))
+}
+
+// HomePhoneHasPrefix applies the HasPrefix predicate on the "home_phone" field.
+func HomePhoneHasPrefix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasPrefix(FieldHomePhone, v))
+}
+
+// HomePhoneHasSuffix applies the HasSuffix predicate on the "home_phone" field.
+func HomePhoneHasSuffix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasSuffix(FieldHomePhone, v))
+}
+
+// HomePhoneEqualFold applies the EqualFold predicate on the "home_phone" field.
+func HomePhoneEqualFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEqualFold(FieldHomePhone, v))
+}
+
+// HomePhoneContainsFold applies the ContainsFold predicate on the "home_phone" field.
+func HomePhoneContainsFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContainsFold(FieldHomePhone, v))
+}
+
+// ExtensionEQ applies the EQ predicate on the "extension" field.
+func ExtensionEQ(v int32) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldExtension, v))
+}
+
+// ExtensionNEQ applies the NEQ predicate on the "extension" field.
+func ExtensionNEQ(v int32) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldExtension, v))
+}
+
+// ExtensionIn applies the In predicate on the "extension" field.
+func ExtensionIn(vs ...int32) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldExtension, vs...))
+}
+
+// ExtensionNotIn applies the NotIn predicate on the "extension" field.
+func ExtensionNotIn(vs ...int32) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldExtension, vs...))
+}
+
+// ExtensionGT applies the GT predicate on the "extension" field.
+func ExtensionGT(v int32) predicate.Employee {
+	return predicate.Employee(sql.FieldGT(FieldExtension, v))
+}
+
+// ExtensionGTE applies the GTE predicate on the "extension" field.
+func ExtensionGTE(v int32) predicate.Employee {
+	return predicate.Employee(sql.FieldGTE(FieldExtension, v))
+}
+
+// ExtensionLT applies the LT predicate on the "extension" field.
+func ExtensionLT(v int32) predicate.Employee {
+	return predicate.Employee(sql.FieldLT(FieldExtension, v))
+}
+
+// ExtensionLTE applies the LTE predicate on the "extension" field.
+func ExtensionLTE(v int32) predicate.Employee {
+	return predicate.Employee(sql.FieldLTE(FieldExtension, v))
+}
+
+// NotesEQ applies the EQ predicate on the "notes" field.
+func NotesEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldNotes, v))
+}
+
+// NotesNEQ applies the NEQ predicate on the "notes" field.
+func NotesNEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldNotes, v))
+}
+
+// NotesIn applies the In predicate on the "notes" field.
+func NotesIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldNotes, vs...))
+}
+
+// NotesNotIn applies the NotIn predicate on the "notes" field.
+func NotesNotIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldNotes, vs...))
+}
+
+// NotesGT applies the GT predicate on the "notes" field.
+func NotesGT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGT(FieldNotes, v))
+}
+
+// NotesGTE applies the GTE predicate on the "notes" field.
+func NotesGTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGTE(FieldNotes, v))
+}
+
+// NotesLT applies the LT predicate on the "notes" field.
+func NotesLT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLT(FieldNotes, v))
+}
+
+// NotesLTE applies the LTE predicate on the "notes" field.
+func NotesLTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLTE(FieldNotes, v))
+}
+
+// NotesContains applies the Contains predicate on the "notes" field.
+func NotesContains(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContains(FieldNotes, v))
+}
+
+// NotesHasPrefix applies the HasPrefix predicate on the "notes" field.
+func NotesHasPrefix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasPrefix(FieldNotes, v))
+}
+
+// NotesHasSuffix applies the HasSuffix predicate on the "notes" field.
+func NotesHasSuffix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasSuffix(FieldNotes, v))
+}
+
+// NotesEqualFold applies the EqualFold predicate on the "notes" field.
+func NotesEqualFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEqualFold(FieldNotes, v))
+}
+
+// NotesContainsFold applies the ContainsFold predicate on the "notes" field.
+func NotesContainsFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContainsFold(FieldNotes, v))
+}
+
+// RecipientIDEQ applies the EQ predicate on the "recipient_id" field.
+func RecipientIDEQ(v int32) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldRecipientID, v))
+}
+
+// RecipientIDNEQ applies the NEQ predicate on the "recipient_id" field.
+func RecipientIDNEQ(v int32) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldRecipientID, v))
+}
+
+// RecipientIDIn applies the In predicate on the "recipient_id" field.
+func RecipientIDIn(vs ...int32) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldRecipientID, vs...))
+}
+
+// RecipientIDNotIn applies the NotIn predicate on the "recipient_id" field.
+func RecipientIDNotIn(vs ...int32) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldRecipientID, vs...))
+}
+
+// RecipientIDIsNil applies the IsNil predicate on the "recipient_id" field.
+func RecipientIDIsNil() predicate.Employee {
+	return predicate.Employee(sql.FieldIsNull(FieldRecipientID))
+}
+
+// RecipientIDNotNil applies the NotNil predicate on the "recipient_id" field.
+func RecipientIDNotNil() predicate.Employee {
+	return predicate.Employee(sql.FieldNotNull(FieldRecipientID))
+}
+
+// HasRecipient applies the HasEdge predicate on the "recipient" edge.
+func HasRecipient() predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RecipientTable, RecipientColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRecipientWith applies the HasEdge predicate on the "recipient" edge with a given conditions (other predicates).
+func HasRecipientWith(preds ...predicate.Employee) predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := newRecipientStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReports applies the HasEdge predicate on the "reports" edge.
+func HasReports() predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReportsTable, ReportsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReportsWith applies the HasEdge predicate on the "reports" edge with a given conditions (other predicates).
+func HasReportsWith(preds ...predicate.Employee) predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := newReportsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Employee) predicate.Employee {
+	return predicate.Employee(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Employee) predicate.Employee {
+	return predicate.Employee(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Employee) predicate.Employee {
+	return predicate.Employee(sql.NotPredicates(p))
+}
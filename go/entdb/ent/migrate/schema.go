@@ -0,0 +1,200 @@
+// Code generated by ent, DO NOT EDIT.
+
+package migrate
+
+import (
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+)
+
+var (
+	// CustomersColumns holds the columns for the "customers" table.
+	CustomersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt32, Increment: true},
+		{Name: "company_name", Type: field.TypeString, Size: 2147483647},
+		{Name: "contact_name", Type: field.TypeString},
+		{Name: "contact_title", Type: field.TypeString},
+		{Name: "address", Type: field.TypeString},
+		{Name: "city", Type: field.TypeString},
+		{Name: "postal_code", Type: field.TypeString, Nullable: true},
+		{Name: "region", Type: field.TypeString, Nullable: true},
+		{Name: "country", Type: field.TypeString},
+		{Name: "phone", Type: field.TypeString},
+		{Name: "fax", Type: field.TypeString, Nullable: true},
+	}
+	// CustomersTable holds the schema information for the "customers" table.
+	CustomersTable = &schema.Table{
+		Name:       "customers",
+		Columns:    CustomersColumns,
+		PrimaryKey: []*schema.Column{CustomersColumns[0]},
+	}
+	// EmployeesColumns holds the columns for the "employees" table.
+	EmployeesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt32, Increment: true},
+		{Name: "last_name", Type: field.TypeString},
+		{Name: "first_name", Type: field.TypeString, Nullable: true},
+		{Name: "title", Type: field.TypeString},
+		{Name: "title_of_courtesy", Type: field.TypeString},
+		{Name: "birth_date", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "hire_date", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "address", Type: field.TypeString},
+		{Name: "city", Type: field.TypeString},
+		{Name: "postal_code", Type: field.TypeString},
+		{Name: "country", Type: field.TypeString},
+		{Name: "home_phone", Type: field.TypeString},
+		{Name: "extension", Type: field.TypeInt32},
+		{Name: "notes", Type: field.TypeString, Size: 2147483647},
+		{Name: "recipient_id", Type: field.TypeInt32, Nullable: true},
+	}
+	// EmployeesTable holds the schema information for the "employees" table.
+	EmployeesTable = &schema.Table{
+		Name:       "employees",
+		Columns:    EmployeesColumns,
+		PrimaryKey: []*schema.Column{EmployeesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "employees_employees_reports",
+				Columns:    []*schema.Column{EmployeesColumns[14]},
+				RefColumns: []*schema.Column{EmployeesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "recepient_idx",
+				Unique:  false,
+				Columns: []*schema.Column{EmployeesColumns[14]},
+			},
+		},
+	}
+	// OrdersColumns holds the columns for the "orders" table.
+	OrdersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt32, Increment: true},
+		{Name: "order_date", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "required_date", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "shipped_date", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "ship_via", Type: field.TypeInt32},
+		{Name: "freight", Type: field.TypeFloat64},
+		{Name: "ship_name", Type: field.TypeString},
+		{Name: "ship_city", Type: field.TypeString},
+		{Name: "ship_region", Type: field.TypeString, Nullable: true},
+		{Name: "ship_postal_code", Type: field.TypeString, Nullable: true},
+		{Name: "ship_country", Type: field.TypeString},
+		{Name: "customer_id", Type: field.TypeInt32},
+		{Name: "employee_id", Type: field.TypeInt32},
+	}
+	// OrdersTable holds the schema information for the "orders" table.
+	OrdersTable = &schema.Table{
+		Name:       "orders",
+		Columns:    OrdersColumns,
+		PrimaryKey: []*schema.Column{OrdersColumns[0]},
+	}
+	// OrderDetailsColumns holds the columns for the "order_details" table.
+	OrderDetailsColumns = []*schema.Column{
+		{Name: "unit_price", Type: field.TypeFloat64},
+		{Name: "quantity", Type: field.TypeInt32},
+		{Name: "discount", Type: field.TypeFloat64},
+		{Name: "order_id", Type: field.TypeInt32},
+		{Name: "product_id", Type: field.TypeInt32},
+	}
+	// OrderDetailsTable holds the schema information for the "order_details" table.
+	OrderDetailsTable = &schema.Table{
+		Name:       "order_details",
+		Columns:    OrderDetailsColumns,
+		PrimaryKey: []*schema.Column{OrderDetailsColumns[3], OrderDetailsColumns[4]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "order_details_orders_order",
+				Columns:    []*schema.Column{OrderDetailsColumns[3]},
+				RefColumns: []*schema.Column{OrdersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "order_details_products_product",
+				Columns:    []*schema.Column{OrderDetailsColumns[4]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "order_id_idx",
+				Unique:  false,
+				Columns: []*schema.Column{OrderDetailsColumns[3]},
+			},
+			{
+				Name:    "product_id_idx",
+				Unique:  false,
+				Columns: []*schema.Column{OrderDetailsColumns[4]},
+			},
+		},
+	}
+	// ProductsColumns holds the columns for the "products" table.
+	ProductsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt32, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 2147483647},
+		{Name: "qt_per_unit", Type: field.TypeString},
+		{Name: "unit_price", Type: field.TypeFloat64},
+		{Name: "units_in_stock", Type: field.TypeInt32},
+		{Name: "units_on_order", Type: field.TypeInt32},
+		{Name: "reorder_level", Type: field.TypeInt32},
+		{Name: "discontinued", Type: field.TypeInt32},
+		{Name: "supplier_id", Type: field.TypeInt32},
+	}
+	// ProductsTable holds the schema information for the "products" table.
+	ProductsTable = &schema.Table{
+		Name:       "products",
+		Columns:    ProductsColumns,
+		PrimaryKey: []*schema.Column{ProductsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "products_suppliers_products",
+				Columns:    []*schema.Column{ProductsColumns[8]},
+				RefColumns: []*schema.Column{SuppliersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "supplier_idx",
+				Unique:  false,
+				Columns: []*schema.Column{ProductsColumns[8]},
+			},
+		},
+	}
+	// SuppliersColumns holds the columns for the "suppliers" table.
+	SuppliersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt32, Increment: true},
+		{Name: "company_name", Type: field.TypeString},
+		{Name: "contact_name", Type: field.TypeString},
+		{Name: "contact_title", Type: field.TypeString},
+		{Name: "address", Type: field.TypeString},
+		{Name: "city", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString, Nullable: true},
+		{Name: "postal_code", Type: field.TypeString},
+		{Name: "country", Type: field.TypeString},
+		{Name: "phone", Type: field.TypeString},
+	}
+	// SuppliersTable holds the schema information for the "suppliers" table.
+	SuppliersTable = &schema.Table{
+		Name:       "suppliers",
+		Columns:    SuppliersColumns,
+		PrimaryKey: []*schema.Column{SuppliersColumns[0]},
+	}
+	// Tables holds all the tables in the schema.
+	Tables = []*schema.Table{
+		CustomersTable,
+		EmployeesTable,
+		OrdersTable,
+		OrderDetailsTable,
+		ProductsTable,
+		SuppliersTable,
+	}
+)
+
+func init() {
+	EmployeesTable.ForeignKeys[0].RefTable = EmployeesTable
+	OrderDetailsTable.ForeignKeys[0].RefTable = OrdersTable
+	OrderDetailsTable.ForeignKeys[1].RefTable = ProductsTable
+	ProductsTable.ForeignKeys[0].RefTable = SuppliersTable
+}
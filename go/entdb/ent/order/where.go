@@ -0,0 +1,812 @@
+// Code generated by ent, DO NOT EDIT.
+
+package order
+
+import (
+	"perf-drizzle/go/entdb/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int32) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int32) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int32) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int32) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int32) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int32) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int32) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int32) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int32) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldID, id))
+}
+
+// OrderDate applies equality check predicate on the "order_date" field. It's identical to OrderDateEQ.
+func OrderDate(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldOrderDate, v))
+}
+
+// RequiredDate applies equality check predicate on the "required_date" field. It's identical to RequiredDateEQ.
+func RequiredDate(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldRequiredDate, v))
+}
+
+// ShippedDate applies equality check predicate on the "shipped_date" field. It's identical to ShippedDateEQ.
+func ShippedDate(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShippedDate, v))
+}
+
+// ShipVia applies equality check predicate on the "ship_via" field. It's identical to ShipViaEQ.
+func ShipVia(v int32) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShipVia, v))
+}
+
+// Freight applies equality check predicate on the "freight" field. It's identical to FreightEQ.
+func Freight(v float64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldFreight, v))
+}
+
+// ShipName applies equality check predicate on the "ship_name" field. It's identical to ShipNameEQ.
+func ShipName(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShipName, v))
+}
+
+// ShipCity applies equality check predicate on the "ship_city" field. It's identical to ShipCityEQ.
+func ShipCity(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShipCity, v))
+}
+
+// ShipRegion applies equality check predicate on the "ship_region" field. It's identical to ShipRegionEQ.
+func ShipRegion(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShipRegion, v))
+}
+
+// ShipPostalCode applies equality check predicate on the "ship_postal_code" field. It's identical to ShipPostalCodeEQ.
+func ShipPostalCode(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShipPostalCode, v))
+}
+
+// ShipCountry applies equality check predicate on the "ship_country" field. It's identical to ShipCountryEQ.
+func ShipCountry(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShipCountry, v))
+}
+
+// CustomerID applies equality check predicate on the "customer_id" field. It's identical to CustomerIDEQ.
+func CustomerID(v int32) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// EmployeeID applies equality check predicate on the "employee_id" field. It's identical to EmployeeIDEQ.
+func EmployeeID(v int32) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldEmployeeID, v))
+}
+
+// OrderDateEQ applies the EQ predicate on the "order_date" field.
+func OrderDateEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldOrderDate, v))
+}
+
+// OrderDateNEQ applies the NEQ predicate on the "order_date" field.
+func OrderDateNEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldOrderDate, v))
+}
+
+// OrderDateIn applies the In predicate on the "order_date" field.
+func OrderDateIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldOrderDate, vs...))
+}
+
+// OrderDateNotIn applies the NotIn predicate on the "order_date" field.
+func OrderDateNotIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldOrderDate, vs...))
+}
+
+// OrderDateGT applies the GT predicate on the "order_date" field.
+func OrderDateGT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldOrderDate, v))
+}
+
+// OrderDateGTE applies the GTE predicate on the "order_date" field.
+func OrderDateGTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldOrderDate, v))
+}
+
+// OrderDateLT applies the LT predicate on the "order_date" field.
+func OrderDateLT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldOrderDate, v))
+}
+
+// OrderDateLTE applies the LTE predicate on the "order_date" field.
+func OrderDateLTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldOrderDate, v))
+}
+
+// RequiredDateEQ applies the EQ predicate on the "required_date" field.
+func RequiredDateEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldRequiredDate, v))
+}
+
+// RequiredDateNEQ applies the NEQ predicate on the "required_date" field.
+func RequiredDateNEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldRequiredDate, v))
+}
+
+// RequiredDateIn applies the In predicate on the "required_date" field.
+func RequiredDateIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldRequiredDate, vs...))
+}
+
+// RequiredDateNotIn applies the NotIn predicate on the "required_date" field.
+func RequiredDateNotIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldRequiredDate, vs...))
+}
+
+// RequiredDateGT applies the GT predicate on the "required_date" field.
+func RequiredDateGT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldRequiredDate, v))
+}
+
+// RequiredDateGTE applies the GTE predicate on the "required_date" field.
+func RequiredDateGTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldRequiredDate, v))
+}
+
+// RequiredDateLT applies the LT predicate on the "required_date" field.
+func RequiredDateLT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldRequiredDate, v))
+}
+
+// RequiredDateLTE applies the LTE predicate on the "required_date" field.
+func RequiredDateLTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldRequiredDate, v))
+}
+
+// ShippedDateEQ applies the EQ predicate on the "shipped_date" field.
+func ShippedDateEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShippedDate, v))
+}
+
+// ShippedDateNEQ applies the NEQ predicate on the "shipped_date" field.
+func ShippedDateNEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldShippedDate, v))
+}
+
+// ShippedDateIn applies the In predicate on the "shipped_date" field.
+func ShippedDateIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldShippedDate, vs...))
+}
+
+// ShippedDateNotIn applies the NotIn predicate on the "shipped_date" field.
+func ShippedDateNotIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldShippedDate, vs...))
+}
+
+// ShippedDateGT applies the GT predicate on the "shipped_date" field.
+func ShippedDateGT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldShippedDate, v))
+}
+
+// ShippedDateGTE applies the GTE predicate on the "shipped_date" field.
+func ShippedDateGTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldShippedDate, v))
+}
+
+// ShippedDateLT applies the LT predicate on the "shipped_date" field.
+func ShippedDateLT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldShippedDate, v))
+}
+
+// ShippedDateLTE applies the LTE predicate on the "shipped_date" field.
+func ShippedDateLTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldShippedDate, v))
+}
+
+// ShippedDateIsNil applies the IsNil predicate on the "shipped_date" field.
+func ShippedDateIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldShippedDate))
+}
+
+// ShippedDateNotNil applies the NotNil predicate on the "shipped_date" field.
+func ShippedDateNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldShippedDate))
+}
+
+// ShipViaEQ applies the EQ predicate on the "ship_via" field.
+func ShipViaEQ(v int32) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShipVia, v))
+}
+
+// ShipViaNEQ applies the NEQ predicate on the "ship_via" field.
+func ShipViaNEQ(v int32) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldShipVia, v))
+}
+
+// ShipViaIn applies the In predicate on the "ship_via" field.
+func ShipViaIn(vs ...int32) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldShipVia, vs...))
+}
+
+// ShipViaNotIn applies the NotIn predicate on the "ship_via" field.
+func ShipViaNotIn(vs ...int32) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldShipVia, vs...))
+}
+
+// ShipViaGT applies the GT predicate on the "ship_via" field.
+func ShipViaGT(v int32) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldShipVia, v))
+}
+
+// ShipViaGTE applies the GTE predicate on the "ship_via" field.
+func ShipViaGTE(v int32) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldShipVia, v))
+}
+
+// ShipViaLT applies the LT predicate on the "ship_via" field.
+func ShipViaLT(v int32) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldShipVia, v))
+}
+
+// ShipViaLTE applies the LTE predicate on the "ship_via" field.
+func ShipViaLTE(v int32) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldShipVia, v))
+}
+
+// FreightEQ applies the EQ predicate on the "freight" field.
+func FreightEQ(v float64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldFreight, v))
+}
+
+// FreightNEQ applies the NEQ predicate on the "freight" field.
+func FreightNEQ(v float64) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldFreight, v))
+}
+
+// FreightIn applies the In predicate on the "freight" field.
+func FreightIn(vs ...float64) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldFreight, vs...))
+}
+
+// FreightNotIn applies the NotIn predicate on the "freight" field.
+func FreightNotIn(vs ...float64) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldFreight, vs...))
+}
+
+// FreightGT applies the GT predicate on the "freight" field.
+func FreightGT(v float64) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldFreight, v))
+}
+
+// FreightGTE applies the GTE predicate on the "freight" field.
+func FreightGTE(v float64) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldFreight, v))
+}
+
+// FreightLT applies the LT predicate on the "freight" field.
+func FreightLT(v float64) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldFreight, v))
+}
+
+// FreightLTE applies the LTE predicate on the "freight" field.
+func FreightLTE(v float64) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldFreight, v))
+}
+
+// ShipNameEQ applies the EQ predicate on the "ship_name" field.
+func ShipNameEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShipName, v))
+}
+
+// ShipNameNEQ applies the NEQ predicate on the "ship_name" field.
+func ShipNameNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldShipName, v))
+}
+
+// ShipNameIn applies the In predicate on the "ship_name" field.
+func ShipNameIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldShipName, vs...))
+}
+
+// ShipNameNotIn applies the NotIn predicate on the "ship_name" field.
+func ShipNameNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldShipName, vs...))
+}
+
+// ShipNameGT applies the GT predicate on the "ship_name" field.
+func ShipNameGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldShipName, v))
+}
+
+// ShipNameGTE applies the GTE predicate on the "ship_name" field.
+func ShipNameGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldShipName, v))
+}
+
+// ShipNameLT applies the LT predicate on the "ship_name" field.
+func ShipNameLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldShipName, v))
+}
+
+// ShipNameLTE applies the LTE predicate on the "ship_name" field.
+func ShipNameLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldShipName, v))
+}
+
+// ShipNameContains applies the Contains predicate on the "ship_name" field.
+func ShipNameContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldShipName, v))
+}
+
+// ShipNameHasPrefix applies the HasPrefix predicate on the "ship_name" field.
+func ShipNameHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldShipName, v))
+}
+
+// ShipNameHasSuffix applies the HasSuffix predicate on the "ship_name" field.
+func ShipNameHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldShipName, v))
+}
+
+// ShipNameEqualFold applies the EqualFold predicate on the "ship_name" field.
+func ShipNameEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldShipName, v))
+}
+
+// ShipNameContainsFold applies the ContainsFold predicate on the "ship_name" field.
+func ShipNameContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldShipName, v))
+}
+
+// ShipCityEQ applies the EQ predicate on the "ship_city" field.
+func ShipCityEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShipCity, v))
+}
+
+// ShipCityNEQ applies the NEQ predicate on the "ship_city" field.
+func ShipCityNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldShipCity, v))
+}
+
+// ShipCityIn applies the In predicate on the "ship_city" field.
+func ShipCityIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldShipCity, vs...))
+}
+
+// ShipCityNotIn applies the NotIn predicate on the "ship_city" field.
+func ShipCityNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldShipCity, vs...))
+}
+
+// ShipCityGT applies the GT predicate on the "ship_city" field.
+func ShipCityGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldShipCity, v))
+}
+
+// ShipCityGTE applies the GTE predicate on the "ship_city" field.
+func ShipCityGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldShipCity, v))
+}
+
+// ShipCityLT applies the LT predicate on the "ship_city" field.
+func ShipCityLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldShipCity, v))
+}
+
+// ShipCityLTE applies the LTE predicate on the "ship_city" field.
+func ShipCityLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldShipCity, v))
+}
+
+// ShipCityContains applies the Contains predicate on the "ship_city" field.
+func ShipCityContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldShipCity, v))
+}
+
+// ShipCityHasPrefix applies the HasPrefix predicate on the "ship_city" field.
+func ShipCityHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldShipCity, v))
+}
+
+// ShipCityHasSuffix applies the HasSuffix predicate on the "ship_city" field.
+func ShipCityHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldShipCity, v))
+}
+
+// ShipCityEqualFold applies the EqualFold predicate on the "ship_city" field.
+func ShipCityEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldShipCity, v))
+}
+
+// ShipCityContainsFold applies the ContainsFold predicate on the "ship_city" field.
+func ShipCityContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldShipCity, v))
+}
+
+// ShipRegionEQ applies the EQ predicate on the "ship_region" field.
+func ShipRegionEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShipRegion, v))
+}
+
+// ShipRegionNEQ applies the NEQ predicate on the "ship_region" field.
+func ShipRegionNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldShipRegion, v))
+}
+
+// ShipRegionIn applies the In predicate on the "ship_region" field.
+func ShipRegionIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldShipRegion, vs...))
+}
+
+// ShipRegionNotIn applies the NotIn predicate on the "ship_region" field.
+func ShipRegionNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldShipRegion, vs...))
+}
+
+// ShipRegionGT applies the GT predicate on the "ship_region" field.
+func ShipRegionGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldShipRegion, v))
+}
+
+// ShipRegionGTE applies the GTE predicate on the "ship_region" field.
+func ShipRegionGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldShipRegion, v))
+}
+
+// ShipRegionLT applies the LT predicate on the "ship_region" field.
+func ShipRegionLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldShipRegion, v))
+}
+
+// ShipRegionLTE applies the LTE predicate on the "ship_region" field.
+func ShipRegionLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldShipRegion, v))
+}
+
+// ShipRegionContains applies the Contains predicate on the "ship_region" field.
+func ShipRegionContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldShipRegion, v))
+}
+
+// ShipRegionHasPrefix applies the HasPrefix predicate on the "ship_region" field.
+func ShipRegionHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldShipRegion, v))
+}
+
+// ShipRegionHasSuffix applies the HasSuffix predicate on the "ship_region" field.
+func ShipRegionHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldShipRegion, v))
+}
+
+// ShipRegionIsNil applies the IsNil predicate on the "ship_region" field.
+func ShipRegionIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldShipRegion))
+}
+
+// ShipRegionNotNil applies the NotNil predicate on the "ship_region" field.
+func ShipRegionNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldShipRegion))
+}
+
+// ShipRegionEqualFold applies the EqualFold predicate on the "ship_region" field.
+func ShipRegionEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldShipRegion, v))
+}
+
+// ShipRegionContainsFold applies the ContainsFold predicate on the "ship_region" field.
+func ShipRegionContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldShipRegion, v))
+}
+
+// ShipPostalCodeEQ applies the EQ predicate on the "ship_postal_code" field.
+func ShipPostalCodeEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShipPostalCode, v))
+}
+
+// ShipPostalCodeNEQ applies the NEQ predicate on the "ship_postal_code" field.
+func ShipPostalCodeNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldShipPostalCode, v))
+}
+
+// ShipPostalCodeIn applies the In predicate on the "ship_postal_code" field.
+func ShipPostalCodeIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldShipPostalCode, vs...))
+}
+
+// ShipPostalCodeNotIn applies the NotIn predicate on the "ship_postal_code" field.
+func ShipPostalCodeNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldShipPostalCode, vs...))
+}
+
+// ShipPostalCodeGT applies the GT predicate on the "ship_postal_code" field.
+func ShipPostalCodeGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldShipPostalCode, v))
+}
+
+// ShipPostalCodeGTE applies the GTE predicate on the "ship_postal_code" field.
+func ShipPostalCodeGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldShipPostalCode, v))
+}
+
+// ShipPostalCodeLT applies the LT predicate on the "ship_postal_code" field.
+func ShipPostalCodeLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldShipPostalCode, v))
+}
+
+// ShipPostalCodeLTE applies the LTE predicate on the "ship_postal_code" field.
+func ShipPostalCodeLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldShipPostalCode, v))
+}
+
+// ShipPostalCodeContains applies the Contains predicate on the "ship_postal_code" field.
+func ShipPostalCodeContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldShipPostalCode, v))
+}
+
+// ShipPostalCodeHasPrefix applies the HasPrefix predicate on the "ship_postal_code" field.
+func ShipPostalCodeHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldShipPostalCode, v))
+}
+
+// ShipPostalCodeHasSuffix applies the HasSuffix predicate on the "ship_postal_code" field.
+func ShipPostalCodeHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldShipPostalCode, v))
+}
+
+// ShipPostalCodeIsNil applies the IsNil predicate on the "ship_postal_code" field.
+func ShipPostalCodeIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldShipPostalCode))
+}
+
+// ShipPostalCodeNotNil applies the NotNil predicate on the "ship_postal_code" field.
+func ShipPostalCodeNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldShipPostalCode))
+}
+
+// ShipPostalCodeEqualFold applies the EqualFold predicate on the "ship_postal_code" field.
+func ShipPostalCodeEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldShipPostalCode, v))
+}
+
+// ShipPostalCodeContainsFold applies the ContainsFold predicate on the "ship_postal_code" field.
+func ShipPostalCodeContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldShipPostalCode, v))
+}
+
+// ShipCountryEQ applies the EQ predicate on the "ship_country" field.
+func ShipCountryEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShipCountry, v))
+}
+
+// ShipCountryNEQ applies the NEQ predicate on the "ship_country" field.
+func ShipCountryNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldShipCountry, v))
+}
+
+// ShipCountryIn applies the In predicate on the "ship_country" field.
+func ShipCountryIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldShipCountry, vs...))
+}
+
+// ShipCountryNotIn applies the NotIn predicate on the "ship_country" field.
+func ShipCountryNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldShipCountry, vs...))
+}
+
+// ShipCountryGT applies the GT predicate on the "ship_country" field.
+func ShipCountryGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldShipCountry, v))
+}
+
+// ShipCountryGTE applies the GTE predicate on the "ship_country" field.
+func ShipCountryGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldShipCountry, v))
+}
+
+// ShipCountryLT applies the LT predicate on the "ship_country" field.
+func ShipCountryLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldShipCountry, v))
+}
+
+// ShipCountryLTE applies the LTE predicate on the "ship_country" field.
+func ShipCountryLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldShipCountry, v))
+}
+
+// ShipCountryContains applies the Contains predicate on the "ship_country" field.
+func ShipCountryContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldShipCountry, v))
+}
+
+// ShipCountryHasPrefix applies the HasPrefix predicate on the "ship_country" field.
+func ShipCountryHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldShipCountry, v))
+}
+
+// ShipCountryHasSuffix applies the HasSuffix predicate on the "ship_country" field.
+func ShipCountryHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldShipCountry, v))
+}
+
+// ShipCountryEqualFold applies the EqualFold predicate on the "ship_country" field.
+func ShipCountryEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldShipCountry, v))
+}
+
+// ShipCountryContainsFold applies the ContainsFold predicate on the "ship_country" field.
+func ShipCountryContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldShipCountry, v))
+}
+
+// CustomerIDEQ applies the EQ predicate on the "customer_id" field.
+func CustomerIDEQ(v int32) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// CustomerIDNEQ applies the NEQ predicate on the "customer_id" field.
+func CustomerIDNEQ(v int32) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldCustomerID, v))
+}
+
+// CustomerIDIn applies the In predicate on the "customer_id" field.
+func CustomerIDIn(vs ...int32) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDNotIn applies the NotIn predicate on the "customer_id" field.
+func CustomerIDNotIn(vs ...int32) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDGT applies the GT predicate on the "customer_id" field.
+func CustomerIDGT(v int32) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldCustomerID, v))
+}
+
+// CustomerIDGTE applies the GTE predicate on the "customer_id" field.
+func CustomerIDGTE(v int32) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldCustomerID, v))
+}
+
+// CustomerIDLT applies the LT predicate on the "customer_id" field.
+func CustomerIDLT(v int32) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldCustomerID, v))
+}
+
+// CustomerIDLTE applies the LTE predicate on the "customer_id" field.
+func CustomerIDLTE(v int32) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldCustomerID, v))
+}
+
+// EmployeeIDEQ applies the EQ predicate on the "employee_id" field.
+func EmployeeIDEQ(v int32) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldEmployeeID, v))
+}
+
+// EmployeeIDNEQ applies the NEQ predicate on the "employee_id" field.
+func EmployeeIDNEQ(v int32) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldEmployeeID, v))
+}
+
+// EmployeeIDIn applies the In predicate on the "employee_id" field.
+func EmployeeIDIn(vs ...int32) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldEmployeeID, vs...))
+}
+
+// EmployeeIDNotIn applies the NotIn predicate on the "employee_id" field.
+func EmployeeIDNotIn(vs ...int32) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldEmployeeID, vs...))
+}
+
+// EmployeeIDGT applies the GT predicate on the "employee_id" field.
+func EmployeeIDGT(v int32) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldEmployeeID, v))
+}
+
+// EmployeeIDGTE applies the GTE predicate on the "employee_id" field.
+func EmployeeIDGTE(v int32) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldEmployeeID, v))
+}
+
+// EmployeeIDLT applies the LT predicate on the "employee_id" field.
+func EmployeeIDLT(v int32) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldEmployeeID, v))
+}
+
+// EmployeeIDLTE applies the LTE predicate on the "employee_id" field.
+func EmployeeIDLTE(v int32) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldEmployeeID, v))
+}
+
+// HasProducts applies the HasEdge predicate on the "products" edge.
+func HasProducts() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, ProductsTable, ProductsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProductsWith applies the HasEdge predicate on the "products" edge with a given conditions (other predicates).
+func HasProductsWith(preds ...predicate.Product) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newProductsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDetails applies the HasEdge predicate on the "details" edge.
+func HasDetails() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, DetailsTable, DetailsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDetailsWith applies the HasEdge predicate on the "details" edge with a given conditions (other predicates).
+func HasDetailsWith(preds ...predicate.OrderDetail) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newDetailsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Order) predicate.Order {
+	return predicate.Order(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Order) predicate.Order {
+	return predicate.Order(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Order) predicate.Order {
+	return predicate.Order(sql.NotPredicates(p))
+}
@@ -0,0 +1,256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package orderdetail
+
+import (
+	"perf-drizzle/go/entdb/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// UnitPrice applies equality check predicate on the "unit_price" field. It's identical to UnitPriceEQ.
+func UnitPrice(v float64) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldEQ(FieldUnitPrice, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v int32) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldEQ(FieldQuantity, v))
+}
+
+// Discount applies equality check predicate on the "discount" field. It's identical to DiscountEQ.
+func Discount(v float64) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldEQ(FieldDiscount, v))
+}
+
+// OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
+func OrderID(v int32) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldEQ(FieldOrderID, v))
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v int32) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldEQ(FieldProductID, v))
+}
+
+// UnitPriceEQ applies the EQ predicate on the "unit_price" field.
+func UnitPriceEQ(v float64) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldEQ(FieldUnitPrice, v))
+}
+
+// UnitPriceNEQ applies the NEQ predicate on the "unit_price" field.
+func UnitPriceNEQ(v float64) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldNEQ(FieldUnitPrice, v))
+}
+
+// UnitPriceIn applies the In predicate on the "unit_price" field.
+func UnitPriceIn(vs ...float64) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldIn(FieldUnitPrice, vs...))
+}
+
+// UnitPriceNotIn applies the NotIn predicate on the "unit_price" field.
+func UnitPriceNotIn(vs ...float64) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldNotIn(FieldUnitPrice, vs...))
+}
+
+// UnitPriceGT applies the GT predicate on the "unit_price" field.
+func UnitPriceGT(v float64) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldGT(FieldUnitPrice, v))
+}
+
+// UnitPriceGTE applies the GTE predicate on the "unit_price" field.
+func UnitPriceGTE(v float64) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldGTE(FieldUnitPrice, v))
+}
+
+// UnitPriceLT applies the LT predicate on the "unit_price" field.
+func UnitPriceLT(v float64) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldLT(FieldUnitPrice, v))
+}
+
+// UnitPriceLTE applies the LTE predicate on the "unit_price" field.
+func UnitPriceLTE(v float64) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldLTE(FieldUnitPrice, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v int32) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v int32) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...int32) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...int32) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v int32) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v int32) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v int32) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v int32) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldLTE(FieldQuantity, v))
+}
+
+// DiscountEQ applies the EQ predicate on the "discount" field.
+func DiscountEQ(v float64) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldEQ(FieldDiscount, v))
+}
+
+// DiscountNEQ applies the NEQ predicate on the "discount" field.
+func DiscountNEQ(v float64) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldNEQ(FieldDiscount, v))
+}
+
+// DiscountIn applies the In predicate on the "discount" field.
+func DiscountIn(vs ...float64) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldIn(FieldDiscount, vs...))
+}
+
+// DiscountNotIn applies the NotIn predicate on the "discount" field.
+func DiscountNotIn(vs ...float64) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldNotIn(FieldDiscount, vs...))
+}
+
+// DiscountGT applies the GT predicate on the "discount" field.
+func DiscountGT(v float64) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldGT(FieldDiscount, v))
+}
+
+// DiscountGTE applies the GTE predicate on the "discount" field.
+func DiscountGTE(v float64) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldGTE(FieldDiscount, v))
+}
+
+// DiscountLT applies the LT predicate on the "discount" field.
+func DiscountLT(v float64) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldLT(FieldDiscount, v))
+}
+
+// DiscountLTE applies the LTE predicate on the "discount" field.
+func DiscountLTE(v float64) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldLTE(FieldDiscount, v))
+}
+
+// OrderIDEQ applies the EQ predicate on the "order_id" field.
+func OrderIDEQ(v int32) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderIDNEQ applies the NEQ predicate on the "order_id" field.
+func OrderIDNEQ(v int32) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldNEQ(FieldOrderID, v))
+}
+
+// OrderIDIn applies the In predicate on the "order_id" field.
+func OrderIDIn(vs ...int32) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldIn(FieldOrderID, vs...))
+}
+
+// OrderIDNotIn applies the NotIn predicate on the "order_id" field.
+func OrderIDNotIn(vs ...int32) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldNotIn(FieldOrderID, vs...))
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v int32) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldEQ(FieldProductID, v))
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v int32) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldNEQ(FieldProductID, v))
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...int32) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldIn(FieldProductID, vs...))
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...int32) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.FieldNotIn(FieldProductID, vs...))
+}
+
+// HasOrder applies the HasEdge predicate on the "order" edge.
+func HasOrder() predicate.OrderDetail {
+	return predicate.OrderDetail(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, OrderColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, OrderTable, OrderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrderWith applies the HasEdge predicate on the "order" edge with a given conditions (other predicates).
+func HasOrderWith(preds ...predicate.Order) predicate.OrderDetail {
+	return predicate.OrderDetail(func(s *sql.Selector) {
+		step := newOrderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasProduct applies the HasEdge predicate on the "product" edge.
+func HasProduct() predicate.OrderDetail {
+	return predicate.OrderDetail(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, ProductColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, ProductTable, ProductColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProductWith applies the HasEdge predicate on the "product" edge with a given conditions (other predicates).
+func HasProductWith(preds ...predicate.Product) predicate.OrderDetail {
+	return predicate.OrderDetail(func(s *sql.Selector) {
+		step := newProductStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OrderDetail) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OrderDetail) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OrderDetail) predicate.OrderDetail {
+	return predicate.OrderDetail(sql.NotPredicates(p))
+}
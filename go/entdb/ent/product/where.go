@@ -0,0 +1,506 @@
+// Code generated by ent, DO NOT EDIT.
+
+package product
+
+import (
+	"perf-drizzle/go/entdb/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int32) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int32) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int32) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int32) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int32) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int32) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int32) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int32) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int32) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldName, v))
+}
+
+// QtPerUnit applies equality check predicate on the "qt_per_unit" field. It's identical to QtPerUnitEQ.
+func QtPerUnit(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldQtPerUnit, v))
+}
+
+// UnitPrice applies equality check predicate on the "unit_price" field. It's identical to UnitPriceEQ.
+func UnitPrice(v float64) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldUnitPrice, v))
+}
+
+// UnitsInStock applies equality check predicate on the "units_in_stock" field. It's identical to UnitsInStockEQ.
+func UnitsInStock(v int32) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldUnitsInStock, v))
+}
+
+// UnitsOnOrder applies equality check predicate on the "units_on_order" field. It's identical to UnitsOnOrderEQ.
+func UnitsOnOrder(v int32) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldUnitsOnOrder, v))
+}
+
+// ReorderLevel applies equality check predicate on the "reorder_level" field. It's identical to ReorderLevelEQ.
+func ReorderLevel(v int32) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldReorderLevel, v))
+}
+
+// Discontinued applies equality check predicate on the "discontinued" field. It's identical to DiscontinuedEQ.
+func Discontinued(v int32) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldDiscontinued, v))
+}
+
+// SupplierID applies equality check predicate on the "supplier_id" field. It's identical to SupplierIDEQ.
+func SupplierID(v int32) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldSupplierID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Product {
+	return predicate.Product(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldContainsFold(FieldName, v))
+}
+
+// QtPerUnitEQ applies the EQ predicate on the "qt_per_unit" field.
+func QtPerUnitEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldQtPerUnit, v))
+}
+
+// QtPerUnitNEQ applies the NEQ predicate on the "qt_per_unit" field.
+func QtPerUnitNEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldQtPerUnit, v))
+}
+
+// QtPerUnitIn applies the In predicate on the "qt_per_unit" field.
+func QtPerUnitIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldQtPerUnit, vs...))
+}
+
+// QtPerUnitNotIn applies the NotIn predicate on the "qt_per_unit" field.
+func QtPerUnitNotIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldQtPerUnit, vs...))
+}
+
+// QtPerUnitGT applies the GT predicate on the "qt_per_unit" field.
+func QtPerUnitGT(v string) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldQtPerUnit, v))
+}
+
+// QtPerUnitGTE applies the GTE predicate on the "qt_per_unit" field.
+func QtPerUnitGTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldQtPerUnit, v))
+}
+
+// QtPerUnitLT applies the LT predicate on the "qt_per_unit" field.
+func QtPerUnitLT(v string) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldQtPerUnit, v))
+}
+
+// QtPerUnitLTE applies the LTE predicate on the "qt_per_unit" field.
+func QtPerUnitLTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldQtPerUnit, v))
+}
+
+// QtPerUnitContains applies the Contains predicate on the "qt_per_unit" field.
+func QtPerUnitContains(v string) predicate.Product {
+	return predicate.Product(sql.FieldContains(FieldQtPerUnit, v))
+}
+
+// QtPerUnitHasPrefix applies the HasPrefix predicate on the "qt_per_unit" field.
+func QtPerUnitHasPrefix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasPrefix(FieldQtPerUnit, v))
+}
+
+// QtPerUnitHasSuffix applies the HasSuffix predicate on the "qt_per_unit" field.
+func QtPerUnitHasSuffix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasSuffix(FieldQtPerUnit, v))
+}
+
+// QtPerUnitEqualFold applies the EqualFold predicate on the "qt_per_unit" field.
+func QtPerUnitEqualFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldEqualFold(FieldQtPerUnit, v))
+}
+
+// QtPerUnitContainsFold applies the ContainsFold predicate on the "qt_per_unit" field.
+func QtPerUnitContainsFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldContainsFold(FieldQtPerUnit, v))
+}
+
+// UnitPriceEQ applies the EQ predicate on the "unit_price" field.
+func UnitPriceEQ(v float64) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldUnitPrice, v))
+}
+
+// UnitPriceNEQ applies the NEQ predicate on the "unit_price" field.
+func UnitPriceNEQ(v float64) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldUnitPrice, v))
+}
+
+// UnitPriceIn applies the In predicate on the "unit_price" field.
+func UnitPriceIn(vs ...float64) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldUnitPrice, vs...))
+}
+
+// UnitPriceNotIn applies the NotIn predicate on the "unit_price" field.
+func UnitPriceNotIn(vs ...float64) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldUnitPrice, vs...))
+}
+
+// UnitPriceGT applies the GT predicate on the "unit_price" field.
+func UnitPriceGT(v float64) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldUnitPrice, v))
+}
+
+// UnitPriceGTE applies the GTE predicate on the "unit_price" field.
+func UnitPriceGTE(v float64) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldUnitPrice, v))
+}
+
+// UnitPriceLT applies the LT predicate on the "unit_price" field.
+func UnitPriceLT(v float64) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldUnitPrice, v))
+}
+
+// UnitPriceLTE applies the LTE predicate on the "unit_price" field.
+func UnitPriceLTE(v float64) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldUnitPrice, v))
+}
+
+// UnitsInStockEQ applies the EQ predicate on the "units_in_stock" field.
+func UnitsInStockEQ(v int32) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldUnitsInStock, v))
+}
+
+// UnitsInStockNEQ applies the NEQ predicate on the "units_in_stock" field.
+func UnitsInStockNEQ(v int32) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldUnitsInStock, v))
+}
+
+// UnitsInStockIn applies the In predicate on the "units_in_stock" field.
+func UnitsInStockIn(vs ...int32) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldUnitsInStock, vs...))
+}
+
+// UnitsInStockNotIn applies the NotIn predicate on the "units_in_stock" field.
+func UnitsInStockNotIn(vs ...int32) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldUnitsInStock, vs...))
+}
+
+// UnitsInStockGT applies the GT predicate on the "units_in_stock" field.
+func UnitsInStockGT(v int32) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldUnitsInStock, v))
+}
+
+// UnitsInStockGTE applies the GTE predicate on the "units_in_stock" field.
+func UnitsInStockGTE(v int32) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldUnitsInStock, v))
+}
+
+// UnitsInStockLT applies the LT predicate on the "units_in_stock" field.
+func UnitsInStockLT(v int32) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldUnitsInStock, v))
+}
+
+// UnitsInStockLTE applies the LTE predicate on the "units_in_stock" field.
+func UnitsInStockLTE(v int32) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldUnitsInStock, v))
+}
+
+// UnitsOnOrderEQ applies the EQ predicate on the "units_on_order" field.
+func UnitsOnOrderEQ(v int32) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldUnitsOnOrder, v))
+}
+
+// UnitsOnOrderNEQ applies the NEQ predicate on the "units_on_order" field.
+func UnitsOnOrderNEQ(v int32) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldUnitsOnOrder, v))
+}
+
+// UnitsOnOrderIn applies the In predicate on the "units_on_order" field.
+func UnitsOnOrderIn(vs ...int32) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldUnitsOnOrder, vs...))
+}
+
+// UnitsOnOrderNotIn applies the NotIn predicate on the "units_on_order" field.
+func UnitsOnOrderNotIn(vs ...int32) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldUnitsOnOrder, vs...))
+}
+
+// UnitsOnOrderGT applies the GT predicate on the "units_on_order" field.
+func UnitsOnOrderGT(v int32) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldUnitsOnOrder, v))
+}
+
+// UnitsOnOrderGTE applies the GTE predicate on the "units_on_order" field.
+func UnitsOnOrderGTE(v int32) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldUnitsOnOrder, v))
+}
+
+// UnitsOnOrderLT applies the LT predicate on the "units_on_order" field.
+func UnitsOnOrderLT(v int32) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldUnitsOnOrder, v))
+}
+
+// UnitsOnOrderLTE applies the LTE predicate on the "units_on_order" field.
+func UnitsOnOrderLTE(v int32) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldUnitsOnOrder, v))
+}
+
+// ReorderLevelEQ applies the EQ predicate on the "reorder_level" field.
+func ReorderLevelEQ(v int32) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldReorderLevel, v))
+}
+
+// ReorderLevelNEQ applies the NEQ predicate on the "reorder_level" field.
+func ReorderLevelNEQ(v int32) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldReorderLevel, v))
+}
+
+// ReorderLevelIn applies the In predicate on the "reorder_level" field.
+func ReorderLevelIn(vs ...int32) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldReorderLevel, vs...))
+}
+
+// ReorderLevelNotIn applies the NotIn predicate on the "reorder_level" field.
+func ReorderLevelNotIn(vs ...int32) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldReorderLevel, vs...))
+}
+
+// ReorderLevelGT applies the GT predicate on the "reorder_level" field.
+func ReorderLevelGT(v int32) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldReorderLevel, v))
+}
+
+// ReorderLevelGTE applies the GTE predicate on the "reorder_level" field.
+func ReorderLevelGTE(v int32) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldReorderLevel, v))
+}
+
+// ReorderLevelLT applies the LT predicate on the "reorder_level" field.
+func ReorderLevelLT(v int32) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldReorderLevel, v))
+}
+
+// ReorderLevelLTE applies the LTE predicate on the "reorder_level" field.
+func ReorderLevelLTE(v int32) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldReorderLevel, v))
+}
+
+// DiscontinuedEQ applies the EQ predicate on the "discontinued" field.
+func DiscontinuedEQ(v int32) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldDiscontinued, v))
+}
+
+// DiscontinuedNEQ applies the NEQ predicate on the "discontinued" field.
+func DiscontinuedNEQ(v int32) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldDiscontinued, v))
+}
+
+// DiscontinuedIn applies the In predicate on the "discontinued" field.
+func DiscontinuedIn(vs ...int32) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldDiscontinued, vs...))
+}
+
+// DiscontinuedNotIn applies the NotIn predicate on the "discontinued" field.
+func DiscontinuedNotIn(vs ...int32) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldDiscontinued, vs...))
+}
+
+// DiscontinuedGT applies the GT predicate on the "discontinued" field.
+func DiscontinuedGT(v int32) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldDiscontinued, v))
+}
+
+// DiscontinuedGTE applies the GTE predicate on the "discontinued" field.
+func DiscontinuedGTE(v int32) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldDiscontinued, v))
+}
+
+// DiscontinuedLT applies the LT predicate on the "discontinued" field.
+func DiscontinuedLT(v int32) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldDiscontinued, v))
+}
+
+// DiscontinuedLTE applies the LTE predicate on the "discontinued" field.
+func DiscontinuedLTE(v int32) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldDiscontinued, v))
+}
+
+// SupplierIDEQ applies the EQ predicate on the "supplier_id" field.
+func SupplierIDEQ(v int32) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldSupplierID, v))
+}
+
+// SupplierIDNEQ applies the NEQ predicate on the "supplier_id" field.
+func SupplierIDNEQ(v int32) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldSupplierID, v))
+}
+
+// SupplierIDIn applies the In predicate on the "supplier_id" field.
+func SupplierIDIn(vs ...int32) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldSupplierID, vs...))
+}
+
+// SupplierIDNotIn applies the NotIn predicate on the "supplier_id" field.
+func SupplierIDNotIn(vs ...int32) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldSupplierID, vs...))
+}
+
+// HasSupplier applies the HasEdge predicate on the "supplier" edge.
+func HasSupplier() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SupplierTable, SupplierColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSupplierWith applies the HasEdge predicate on the "supplier" edge with a given conditions (other predicates).
+func HasSupplierWith(preds ...predicate.Supplier) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := newSupplierStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOrders applies the HasEdge predicate on the "orders" edge.
+func HasOrders() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, OrdersTable, OrdersPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrdersWith applies the HasEdge predicate on the "orders" edge with a given conditions (other predicates).
+func HasOrdersWith(preds ...predicate.Order) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := newOrdersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Product) predicate.Product {
+	return predicate.Product(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Product) predicate.Product {
+	return predicate.Product(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Product) predicate.Product {
+	return predicate.Product(sql.NotPredicates(p))
+}
@@ -0,0 +1,26 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+type Customer struct {
+	ent.Schema
+}
+
+func (Customer) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int32("id"),
+		field.Text("company_name"),
+		field.String("contact_name"),
+		field.String("contact_title"),
+		field.String("address"),
+		field.String("city"),
+		field.String("postal_code").Optional().Nillable(),
+		field.String("region").Optional().Nillable(),
+		field.String("country"),
+		field.String("phone"),
+		field.String("fax").Optional().Nillable(),
+	}
+}
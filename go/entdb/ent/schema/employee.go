@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type Employee struct {
+	ent.Schema
+}
+
+func (Employee) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int32("id"),
+		field.String("last_name"),
+		field.String("first_name").Optional().Nillable(),
+		field.String("title"),
+		field.String("title_of_courtesy"),
+		field.Time("birth_date").SchemaType(date),
+		field.Time("hire_date").SchemaType(date),
+		field.String("address"),
+		field.String("city"),
+		field.String("postal_code"),
+		field.String("country"),
+		field.String("home_phone"),
+		field.Int32("extension"),
+		field.Text("notes"),
+		field.Int32("recipient_id").Optional().Nillable(),
+	}
+}
+
+// Edges has the self-referencing recipient of employees.recipient_id and
+// its inverse.
+func (Employee) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("reports", Employee.Type).
+			From("recipient").
+			Unique().
+			Field("recipient_id"),
+	}
+}
+
+func (Employee) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("recipient_id").StorageKey("recepient_idx"),
+	}
+}
+
+// date maps time fields to the Postgres date columns of the Drizzle schema.
+var date = map[string]string{dialect.Postgres: "date"}
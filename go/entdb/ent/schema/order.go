@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+type Order struct {
+	ent.Schema
+}
+
+func (Order) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int32("id"),
+		field.Time("order_date").SchemaType(date),
+		field.Time("required_date").SchemaType(date),
+		field.Time("shipped_date").SchemaType(date).Optional().Nillable(),
+		field.Int32("ship_via"),
+		field.Float("freight"),
+		field.String("ship_name"),
+		field.String("ship_city"),
+		field.String("ship_region").Optional().Nillable(),
+		field.String("ship_postal_code").Optional().Nillable(),
+		field.String("ship_country"),
+		field.Int32("customer_id"),
+		field.Int32("employee_id"),
+	}
+}
+
+// Edges reaches products through the order_details edge schema, which ent
+// loads with WithDetails.
+func (Order) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("products", Product.Type).
+			Through("details", OrderDetail.Type),
+	}
+}
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OrderDetail has no id column, so it is an edge schema identified by its
+// order and product.
+type OrderDetail struct {
+	ent.Schema
+}
+
+func (OrderDetail) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		field.ID("order_id", "product_id"),
+	}
+}
+
+func (OrderDetail) Fields() []ent.Field {
+	return []ent.Field{
+		field.Float("unit_price"),
+		field.Int32("quantity"),
+		field.Float("discount"),
+		field.Int32("order_id"),
+		field.Int32("product_id"),
+	}
+}
+
+func (OrderDetail) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("order", Order.Type).
+			Unique().
+			Required().
+			Field("order_id"),
+		edge.To("product", Product.Type).
+			Unique().
+			Required().
+			Field("product_id"),
+	}
+}
+
+func (OrderDetail) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("order_id").StorageKey("order_id_idx"),
+		index.Fields("product_id").StorageKey("product_id_idx"),
+	}
+}
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type Product struct {
+	ent.Schema
+}
+
+func (Product) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int32("id"),
+		field.Text("name"),
+		field.String("qt_per_unit"),
+		field.Float("unit_price"),
+		field.Int32("units_in_stock"),
+		field.Int32("units_on_order"),
+		field.Int32("reorder_level"),
+		field.Int32("discontinued"),
+		field.Int32("supplier_id"),
+	}
+}
+
+func (Product) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("supplier", Supplier.Type).
+			Ref("products").
+			Unique().
+			Required().
+			Field("supplier_id"),
+		edge.From("orders", Order.Type).
+			Ref("products"),
+	}
+}
+
+func (Product) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("supplier_id").StorageKey("supplier_idx"),
+	}
+}
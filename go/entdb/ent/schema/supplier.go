@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+type Supplier struct {
+	ent.Schema
+}
+
+func (Supplier) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int32("id"),
+		field.String("company_name"),
+		field.String("contact_name"),
+		field.String("contact_title"),
+		field.String("address"),
+		field.String("city"),
+		field.String("region").Optional().Nillable(),
+		field.String("postal_code"),
+		field.String("country"),
+		field.String("phone"),
+	}
+}
+
+func (Supplier) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("products", Product.Type),
+	}
+}
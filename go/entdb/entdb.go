@@ -0,0 +1,515 @@
+// Package entdb implements db.Store with ent, eager loading relations with the
+// generated With* builders. The schema lives in ./ent/schema; run go generate
+// ./go/entdb/ent after changing it.
+package entdb
+
+import (
+	"context"
+	"database/sql"
+	"perf-drizzle/go/db"
+	"perf-drizzle/go/entdb/ent"
+	"perf-drizzle/go/entdb/ent/customer"
+	"perf-drizzle/go/entdb/ent/employee"
+	"perf-drizzle/go/entdb/ent/order"
+	"perf-drizzle/go/entdb/ent/orderdetail"
+	"perf-drizzle/go/entdb/ent/product"
+	"perf-drizzle/go/entdb/ent/supplier"
+	"perf-drizzle/go/stdlibdb"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/bytedance/sonic"
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+type Store struct {
+	client *ent.Client
+	sqlDB  *sql.DB
+}
+
+func New(databaseUrl string, pool stdlibdb.PoolConfig) (*Store, error) {
+	sqlDB, err := sql.Open("pgx", databaseUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	pool.Apply(sqlDB)
+
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, sqlDB)))
+
+	return &Store{client: client, sqlDB: sqlDB}, nil
+}
+
+func (s *Store) Ping(ctx context.Context) error {
+	return s.sqlDB.PingContext(ctx)
+}
+
+func (s *Store) Close() {
+	s.client.Close()
+}
+
+func (s *Store) Customers(ctx context.Context, arg db.CustomersParams) ([]db.Customer, error) {
+	customers, err := s.client.Customer.Query().
+		Order(ent.Asc(customer.FieldID)).
+		Limit(int(arg.Limit)).
+		Offset(int(arg.Offset)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.Customer, len(customers))
+	for i, c := range customers {
+		items[i] = customerRow(c)
+	}
+	return items, nil
+}
+
+func (s *Store) CustomerById(ctx context.Context, id int32) (db.Customer, error) {
+	c, err := s.client.Customer.Get(ctx, id)
+	if err != nil {
+		return db.Customer{}, notFound(err)
+	}
+	return customerRow(c), nil
+}
+
+func (s *Store) SearchCustomer(ctx context.Context, arg db.SearchParams) ([]db.Customer, error) {
+	mode, query := string(arg.Mode), arg.Query()
+	customers, err := s.client.Customer.Query().
+		Where(match(customer.FieldCompanyName, mode, query)).
+		Order(rank(customer.FieldCompanyName, mode, query), ent.Asc(customer.FieldID)).
+		Limit(int(arg.Limit)).
+		Offset(int(arg.Offset)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.Customer, len(customers))
+	for i, c := range customers {
+		items[i] = customerRow(c)
+	}
+	return items, nil
+}
+
+func (s *Store) SearchCustomerHeadline(ctx context.Context, arg db.SearchParams) ([]db.SearchCustomerHeadlineRow, error) {
+	mode, query := string(arg.Mode), arg.Query()
+	q := s.client.Customer.Query().
+		Where(match(customer.FieldCompanyName, mode, query)).
+		Order(rank(customer.FieldCompanyName, mode, query), ent.Asc(customer.FieldID)).
+		Limit(int(arg.Limit)).
+		Offset(int(arg.Offset))
+	q.Modify(headline(customer.FieldCompanyName, mode, query))
+
+	customers, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.SearchCustomerHeadlineRow, len(customers))
+	for i, c := range customers {
+		row := customerRow(c)
+		items[i] = db.SearchCustomerHeadlineRow{
+			ID:           row.ID,
+			CompanyName:  row.CompanyName,
+			ContactName:  row.ContactName,
+			ContactTitle: row.ContactTitle,
+			Address:      row.Address,
+			City:         row.City,
+			PostalCode:   row.PostalCode,
+			Region:       row.Region,
+			Country:      row.Country,
+			Phone:        row.Phone,
+			Fax:          row.Fax,
+			Headline:     valueString(c.Value(headlineColumn)),
+		}
+	}
+	return items, nil
+}
+
+func (s *Store) Employees(ctx context.Context, arg db.EmployeesParams) ([]db.EmployeesRow, error) {
+	employees, err := s.client.Employee.Query().
+		Order(ent.Asc(employee.FieldID)).
+		Limit(int(arg.Limit)).
+		Offset(int(arg.Offset)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.EmployeesRow, len(employees))
+	for i, e := range employees {
+		items[i] = employeeRow(e)
+	}
+	return items, nil
+}
+
+func (s *Store) EmployeeWithRecipient(ctx context.Context, id int32) ([]db.EmployeeWithRecipientRow, error) {
+	employees, err := s.client.Employee.Query().
+		Where(employee.ID(id)).
+		WithRecipient().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.EmployeeWithRecipientRow, len(employees))
+	for i, e := range employees {
+		var recipient db.RawJSON
+		if e.Edges.Recipient != nil {
+			if recipient, err = sonic.Marshal(employeeRow(e.Edges.Recipient)); err != nil {
+				return nil, err
+			}
+		}
+
+		items[i] = employeeRow(e).WithRecipient(recipient)
+	}
+	return items, nil
+}
+
+func (s *Store) Suppliers(ctx context.Context, arg db.SuppliersParams) ([]db.Supplier, error) {
+	suppliers, err := s.client.Supplier.Query().
+		Order(ent.Asc(supplier.FieldID)).
+		Limit(int(arg.Limit)).
+		Offset(int(arg.Offset)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.Supplier, len(suppliers))
+	for i, sp := range suppliers {
+		items[i] = supplierRow(sp)
+	}
+	return items, nil
+}
+
+func (s *Store) SupplierById(ctx context.Context, id int32) (db.Supplier, error) {
+	sp, err := s.client.Supplier.Get(ctx, id)
+	if err != nil {
+		return db.Supplier{}, notFound(err)
+	}
+	return supplierRow(sp), nil
+}
+
+func (s *Store) Products(ctx context.Context, arg db.ProductsParams) ([]db.ProductsRow, error) {
+	products, err := s.client.Product.Query().
+		Order(ent.Asc(product.FieldID)).
+		Limit(int(arg.Limit)).
+		Offset(int(arg.Offset)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.ProductsRow, len(products))
+	for i, p := range products {
+		items[i] = productRow(p)
+	}
+	return items, nil
+}
+
+func (s *Store) ProductWithSupplier(ctx context.Context, id int32) ([]db.ProductWithSupplierRow, error) {
+	products, err := s.client.Product.Query().
+		Where(product.ID(id)).
+		WithSupplier().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.ProductWithSupplierRow, len(products))
+	for i, p := range products {
+		var supplier db.RawJSON
+		if p.Edges.Supplier != nil {
+			if supplier, err = sonic.Marshal(supplierRow(p.Edges.Supplier)); err != nil {
+				return nil, err
+			}
+		}
+
+		items[i] = productRow(p).WithSupplier(supplier)
+	}
+	return items, nil
+}
+
+func (s *Store) SearchProduct(ctx context.Context, arg db.SearchParams) ([]db.SearchProductRow, error) {
+	mode, query := string(arg.Mode), arg.Query()
+	products, err := s.client.Product.Query().
+		Where(match(product.FieldName, mode, query)).
+		Order(rank(product.FieldName, mode, query), ent.Asc(product.FieldID)).
+		Limit(int(arg.Limit)).
+		Offset(int(arg.Offset)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.SearchProductRow, len(products))
+	for i, p := range products {
+		items[i] = db.SearchProductRow(productRow(p))
+	}
+	return items, nil
+}
+
+func (s *Store) SearchProductHeadline(ctx context.Context, arg db.SearchParams) ([]db.SearchProductHeadlineRow, error) {
+	mode, query := string(arg.Mode), arg.Query()
+	q := s.client.Product.Query().
+		Where(match(product.FieldName, mode, query)).
+		Order(rank(product.FieldName, mode, query), ent.Asc(product.FieldID)).
+		Limit(int(arg.Limit)).
+		Offset(int(arg.Offset))
+	q.Modify(headline(product.FieldName, mode, query))
+
+	products, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.SearchProductHeadlineRow, len(products))
+	for i, p := range products {
+		row := productRow(p)
+		items[i] = db.SearchProductHeadlineRow{
+			ID:              row.ID,
+			Name:            row.Name,
+			QuantityPerUnit: row.QuantityPerUnit,
+			UnitPrice:       row.UnitPrice,
+			UnitsInStock:    row.UnitsInStock,
+			UnitsOnOrder:    row.UnitsOnOrder,
+			ReorderLevel:    row.ReorderLevel,
+			Discontinued:    row.Discontinued,
+			SupplierId:      row.SupplierId,
+			Headline:        valueString(p.Value(headlineColumn)),
+		}
+	}
+	return items, nil
+}
+
+func (s *Store) OrdersWithDetails(ctx context.Context, arg db.OrdersWithDetailsParams) ([]db.OrdersWithDetailsRow, error) {
+	items := []db.OrdersWithDetailsRow{}
+	err := s.client.Order.Query().
+		Order(ent.Asc(order.FieldID)).
+		Limit(int(arg.Limit)).
+		Offset(int(arg.Offset)).
+		Modify(orderTotals).
+		Scan(ctx, &items)
+	return items, err
+}
+
+func (s *Store) OrderWithDetails(ctx context.Context, id int32) ([]db.OrderWithDetailsRow, error) {
+	items := []db.OrderWithDetailsRow{}
+	err := s.client.Order.Query().
+		Where(order.ID(id)).
+		Order(ent.Asc(order.FieldID)).
+		Modify(orderTotals).
+		Scan(ctx, &items)
+	return items, err
+}
+
+func (s *Store) OrderWithDetailsAndProducts(ctx context.Context, id int32) ([]db.OrderWithDetailsAndProductsRow, error) {
+	orders, err := s.client.Order.Query().
+		Where(order.ID(id)).
+		WithDetails(func(q *ent.OrderDetailQuery) {
+			q.WithProduct()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.OrderWithDetailsAndProductsRow, len(orders))
+	for i, o := range orders {
+		details := make([]db.OrderDetailWithProduct, len(o.Edges.Details))
+		for j, d := range o.Edges.Details {
+			details[j].OrderDetail = db.OrderDetail{
+				UnitPrice: d.UnitPrice,
+				Quantity:  d.Quantity,
+				Discount:  d.Discount,
+				OrderID:   d.OrderID,
+				ProductID: d.ProductID,
+			}
+			if d.Edges.Product != nil {
+				p := productRow(d.Edges.Product)
+				details[j].Product = &p
+			}
+		}
+
+		raw, err := sonic.Marshal(details)
+		if err != nil {
+			return nil, err
+		}
+
+		items[i] = orderRow(o).WithDetails(raw)
+	}
+	return items, nil
+}
+
+// orderTotals turns an order query into the aggregate of the OrderWithDetails
+// queries. ent scans by JSON tag, so the columns are aliased to those of
+// db.OrdersWithDetailsRow.
+func orderTotals(s *entsql.Selector) {
+	d := entsql.Table(orderdetail.Table)
+	s.LeftJoin(d).On(s.C(order.FieldID), d.C(orderdetail.FieldOrderID)).
+		Select(
+			s.C(order.FieldID),
+			entsql.As(s.C(order.FieldShippedDate), "shippedDate"),
+			entsql.As(s.C(order.FieldShipName), "shipName"),
+			entsql.As(s.C(order.FieldShipCity), "shipCity"),
+			entsql.As(s.C(order.FieldShipCountry), "shipCountry"),
+		).
+		AppendSelectExprAs(entsql.Raw("count("+d.C(orderdetail.FieldProductID)+")::int"), "productsCount").
+		AppendSelectExprAs(entsql.Raw("sum("+d.C(orderdetail.FieldQuantity)+")::int"), "quantitySum").
+		AppendSelectExprAs(entsql.Raw("sum("+d.C(orderdetail.FieldQuantity)+" * "+d.C(orderdetail.FieldUnitPrice)+")::real"), "totalPrice").
+		GroupBy(s.C(order.FieldID))
+}
+
+const headlineColumn = "headline"
+
+// match, rank and headline build the full-text search clauses of the sqlc
+// search queries over column.
+func match(column, mode, query string) func(*entsql.Selector) {
+	return func(s *entsql.Selector) {
+		s.Where(entsql.P(func(b *entsql.Builder) {
+			writeVector(b, s.C(column))
+			b.WriteString(" @@ ")
+			writeTSQuery(b, mode, query)
+		}))
+	}
+}
+
+func rank(column, mode, query string) func(*entsql.Selector) {
+	return func(s *entsql.Selector) {
+		// Not OrderExprFunc, which renders the expression without its arguments.
+		s.OrderExpr(entsql.ExprFunc(func(b *entsql.Builder) {
+			b.WriteString("ts_rank(")
+			writeVector(b, s.C(column))
+			b.Comma()
+			writeTSQuery(b, mode, query)
+			b.WriteString(") DESC")
+		}))
+	}
+}
+
+func headline(column, mode, query string) func(*entsql.Selector) {
+	return func(s *entsql.Selector) {
+		s.AppendSelectExprAs(entsql.ExprFunc(func(b *entsql.Builder) {
+			b.WriteString("ts_headline('english', ").Ident(s.C(column)).Comma()
+			writeTSQuery(b, mode, query)
+			b.WriteString(")::text")
+		}), headlineColumn)
+	}
+}
+
+func writeVector(b *entsql.Builder, column string) {
+	b.WriteString("to_tsvector('english', ").Ident(column).WriteString(")")
+}
+
+func writeTSQuery(b *entsql.Builder, mode, query string) {
+	b.WriteString(db.TSQueryFunc(mode)).WriteString("('english', ").Arg(query).WriteString(")")
+}
+
+func valueString(v ent.Value, err error) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		return ""
+	}
+}
+
+func customerRow(c *ent.Customer) db.Customer {
+	return db.Customer{
+		ID:           c.ID,
+		CompanyName:  c.CompanyName,
+		ContactName:  c.ContactName,
+		ContactTitle: c.ContactTitle,
+		Address:      c.Address,
+		City:         c.City,
+		PostalCode:   c.PostalCode,
+		Region:       c.Region,
+		Country:      c.Country,
+		Phone:        c.Phone,
+		Fax:          c.Fax,
+	}
+}
+
+func employeeRow(e *ent.Employee) db.EmployeesRow {
+	return db.EmployeesRow{
+		ID:              e.ID,
+		LastName:        e.LastName,
+		FirstName:       e.FirstName,
+		Title:           e.Title,
+		TitleOfCourtesy: e.TitleOfCourtesy,
+		BirthDate:       db.DateOf(&e.BirthDate),
+		HireDate:        db.DateOf(&e.HireDate),
+		Address:         e.Address,
+		City:            e.City,
+		PostalCode:      e.PostalCode,
+		Country:         e.Country,
+		HomePhone:       e.HomePhone,
+		Extension:       e.Extension,
+		Notes:           e.Notes,
+		RecipientId:     e.RecipientID,
+	}
+}
+
+func supplierRow(sp *ent.Supplier) db.Supplier {
+	return db.Supplier{
+		ID:           sp.ID,
+		CompanyName:  sp.CompanyName,
+		ContactName:  sp.ContactName,
+		ContactTitle: sp.ContactTitle,
+		Address:      sp.Address,
+		City:         sp.City,
+		Region:       sp.Region,
+		PostalCode:   sp.PostalCode,
+		Country:      sp.Country,
+		Phone:        sp.Phone,
+	}
+}
+
+func productRow(p *ent.Product) db.ProductsRow {
+	return db.ProductsRow{
+		ID:              p.ID,
+		Name:            p.Name,
+		QuantityPerUnit: p.QtPerUnit,
+		UnitPrice:       p.UnitPrice,
+		UnitsInStock:    p.UnitsInStock,
+		UnitsOnOrder:    p.UnitsOnOrder,
+		ReorderLevel:    p.ReorderLevel,
+		Discontinued:    p.Discontinued,
+		SupplierId:      p.SupplierID,
+	}
+}
+
+func orderRow(o *ent.Order) db.Order {
+	return db.Order{
+		ID:             o.ID,
+		OrderDate:      db.DateOf(&o.OrderDate),
+		RequiredDate:   db.DateOf(&o.RequiredDate),
+		ShippedDate:    db.DateOf(o.ShippedDate),
+		ShipVia:        o.ShipVia,
+		Freight:        o.Freight,
+		ShipName:       o.ShipName,
+		ShipCity:       o.ShipCity,
+		ShipRegion:     o.ShipRegion,
+		ShipPostalCode: o.ShipPostalCode,
+		ShipCountry:    o.ShipCountry,
+		CustomerID:     o.CustomerID,
+		EmployeeID:     o.EmployeeID,
+	}
+}
+
+func notFound(err error) error {
+	if ent.IsNotFound(err) {
+		return sql.ErrNoRows
+	}
+	return err
+}
+
+var (
+	_ db.Store       = (*Store)(nil)
+	_ db.Highlighter = (*Store)(nil)
+	_ db.Pinger      = (*Store)(nil)
+)
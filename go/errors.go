@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"perf-drizzle/go/db"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/puddle/v2"
+	"github.com/lib/pq"
+)
+
+// statusClientClosedRequest is nginx's convention for a request whose client
+// went away before the response was ready.
+const statusClientClosedRequest = 499
+
+const (
+	codeNotFound     = "not_found"
+	codeInvalidInput = "invalid_input"
+	codeUnavailable  = "unavailable"
+	codeTimeout      = "timeout"
+	codeCanceled     = "canceled"
+	codeInternal     = "internal"
+)
+
+// APIError is the body of every non-2xx response.
+type APIError struct {
+	Status  int    `json:"status"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Param   string `json:"param,omitempty"`
+	PgCode  string `json:"pgCode,omitempty"`
+}
+
+func (e *APIError) Error() string {
+	return e.Message
+}
+
+func errorHandler(c fiber.Ctx, err error) error {
+	e := toAPIError(err)
+
+	return c.Status(e.Status).JSON(e)
+}
+
+// deadline gives the handlers after it a context that expires after timeout,
+// which the stores pass on to the pool and to the query. Fiber's own context
+// never expires, so without it an exhausted pool just blocks.
+func deadline(timeout time.Duration) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		ctx, cancel := context.WithTimeout(c.Context(), timeout)
+		defer cancel()
+
+		c.SetContext(ctx)
+		return c.Next()
+	}
+}
+
+// toAPIError tells a request that ran out of time waiting for a pgxpool
+// connection, which only the stores over pgxpool can prove with a
+// db.AcquireError, from one whose query did. The drivers report the latter as
+// SQLSTATE 57014, wrap it in a timeout error, or return the bare context
+// error, as lib/pq and database/sql's rows.Err do.
+func toAPIError(err error) *APIError {
+	var (
+		apiErr     *APIError
+		fiberErr   *fiber.Error
+		pgErr      *pgconn.PgError
+		pqErr      *pq.Error
+		acquireErr *db.AcquireError
+	)
+
+	switch {
+	case errors.As(err, &apiErr):
+		return apiErr
+	case errors.As(err, &fiberErr):
+		return &APIError{Status: fiberErr.Code, Code: codeForStatus(fiberErr.Code), Message: fiberErr.Message}
+	case errors.Is(err, sql.ErrNoRows): // pgx.ErrNoRows wraps it too
+		return &APIError{Status: fiber.StatusNotFound, Code: codeNotFound, Message: "record not found"}
+	case errors.As(err, &pgErr):
+		status := statusForPgCode(pgErr.Code)
+		return &APIError{Status: status, Code: codeForStatus(status), Message: pgErr.Message, PgCode: pgErr.Code}
+	case errors.As(err, &pqErr):
+		status := statusForPgCode(string(pqErr.Code))
+		return &APIError{Status: status, Code: codeForStatus(status), Message: pqErr.Message, PgCode: string(pqErr.Code)}
+	case errors.Is(err, puddle.ErrClosedPool):
+		return &APIError{Status: fiber.StatusServiceUnavailable, Code: codeUnavailable, Message: err.Error()}
+	case errors.As(err, &acquireErr) && errors.Is(err, context.DeadlineExceeded):
+		return &APIError{Status: fiber.StatusServiceUnavailable, Code: codeUnavailable, Message: "timed out waiting for a database connection"}
+	case pgconn.Timeout(err), errors.Is(err, context.DeadlineExceeded):
+		return &APIError{Status: fiber.StatusGatewayTimeout, Code: codeTimeout, Message: "query timed out: " + err.Error()}
+	case errors.Is(err, context.Canceled):
+		return &APIError{Status: statusClientClosedRequest, Code: codeCanceled, Message: err.Error()}
+	default:
+		return &APIError{Status: fiber.StatusInternalServerError, Code: codeInternal, Message: err.Error()}
+	}
+}
+
+// statusForPgCode maps SQLSTATE codes, see
+// https://www.postgresql.org/docs/current/errcodes-appendix.html
+func statusForPgCode(code string) int {
+	switch {
+	case code == "57014": // query_canceled, raised by statement_timeout
+		return fiber.StatusGatewayTimeout
+	case code == "57P03": // cannot_connect_now
+		return fiber.StatusServiceUnavailable
+	case strings.HasPrefix(code, "53"): // insufficient_resources, including too_many_connections
+		return fiber.StatusServiceUnavailable
+	case strings.HasPrefix(code, "22"): // data_exception
+		return fiber.StatusBadRequest
+	case code == "42601": // syntax_error, raised by to_tsquery for malformed terms
+		return fiber.StatusBadRequest
+	default:
+		return fiber.StatusInternalServerError
+	}
+}
+
+func codeForStatus(status int) string {
+	switch status {
+	case fiber.StatusNotFound:
+		return codeNotFound
+	case fiber.StatusBadRequest:
+		return codeInvalidInput
+	case fiber.StatusServiceUnavailable:
+		return codeUnavailable
+	case fiber.StatusGatewayTimeout:
+		return codeTimeout
+	case fiber.StatusInternalServerError:
+		return codeInternal
+	default:
+		return strings.ReplaceAll(strings.ToLower(http.StatusText(status)), " ", "_")
+	}
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net"
+	"perf-drizzle/go/db"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/puddle/v2"
+	"github.com/lib/pq"
+)
+
+// stalled returns the URL of a server that accepts connections and never
+// answers, so whatever waits on it runs out of time.
+func stalled(t *testing.T) string {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			t.Cleanup(func() { conn.Close() })
+		}
+	}()
+
+	return "postgres://bench@" + l.Addr().String() + "/bench?sslmode=disable"
+}
+
+func shortContext(t *testing.T) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+// acquireTimeout is what db.Acquire returns when the pool has no connection
+// to give before the deadline.
+func acquireTimeout(t *testing.T) error {
+	pool, err := pgxpool.New(context.Background(), stalled(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(pool.Close)
+
+	_, err = db.Acquire(shortContext(t), pool)
+	return err
+}
+
+// pgxTimeout is what pgx returns when a deadline expires on the wire.
+func pgxTimeout(t *testing.T) error {
+	_, err := pgx.Connect(shortContext(t), stalled(t))
+	return err
+}
+
+func TestToAPIError(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    func(t *testing.T) error
+		status int
+		code   string
+	}{
+		{"acquire timeout", acquireTimeout, fiber.StatusServiceUnavailable, codeUnavailable},
+		{"acquire from a closed pool", func(*testing.T) error { return &db.AcquireError{Err: puddle.ErrClosedPool} }, fiber.StatusServiceUnavailable, codeUnavailable},
+		{"pgx timeout", pgxTimeout, fiber.StatusGatewayTimeout, codeTimeout},
+		{"statement timeout", func(*testing.T) error { return &pgconn.PgError{Code: "57014"} }, fiber.StatusGatewayTimeout, codeTimeout},
+		{"lib/pq statement timeout", func(*testing.T) error { return &pq.Error{Code: "57014"} }, fiber.StatusGatewayTimeout, codeTimeout},
+		// lib/pq returns the bare context error when a running query times out.
+		{"lib/pq query timeout", func(*testing.T) error { return context.DeadlineExceeded }, fiber.StatusGatewayTimeout, codeTimeout},
+		// database/sql's rows.Err does too when the rows are still streaming.
+		{"rows timeout", func(*testing.T) error { return fmt.Errorf("scan orders: %w", context.DeadlineExceeded) }, fiber.StatusGatewayTimeout, codeTimeout},
+		{"canceled", func(*testing.T) error { return context.Canceled }, statusClientClosedRequest, codeCanceled},
+		{"too many connections", func(*testing.T) error { return &pq.Error{Code: "53300"} }, fiber.StatusServiceUnavailable, codeUnavailable},
+		{"malformed term", func(*testing.T) error { return &pgconn.PgError{Code: "42601"} }, fiber.StatusBadRequest, codeInvalidInput},
+		{"not found", func(*testing.T) error { return fmt.Errorf("customer: %w", pgx.ErrNoRows) }, fiber.StatusNotFound, codeNotFound},
+		{"database/sql not found", func(*testing.T) error { return sql.ErrNoRows }, fiber.StatusNotFound, codeNotFound},
+		{"fiber", func(*testing.T) error { return fiber.ErrMethodNotAllowed }, fiber.StatusMethodNotAllowed, "method_not_allowed"},
+		{"unknown", func(*testing.T) error { return errors.New("boom") }, fiber.StatusInternalServerError, codeInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.err(t)
+			if err == nil {
+				t.Fatal("no error to map")
+			}
+
+			got := toAPIError(err)
+			if got.Status != tt.status || got.Code != tt.code {
+				t.Errorf("toAPIError(%v) = %d %s, want %d %s", err, got.Status, got.Code, tt.status, tt.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,303 @@
+// Package gormdb implements db.Store with GORM, loading relations with
+// Preload the way a typical GORM application would.
+package gormdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"perf-drizzle/go/db"
+	"perf-drizzle/go/stdlibdb"
+
+	"github.com/bytedance/sonic"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/logger"
+)
+
+type employee struct {
+	db.Employee
+	Recipient *db.Employee `gorm:"foreignKey:RecipientID"`
+}
+
+func (employee) TableName() string { return "employees" }
+
+type product struct {
+	db.Product
+	Supplier *db.Supplier `gorm:"foreignKey:SupplierID"`
+}
+
+func (product) TableName() string { return "products" }
+
+type orderDetail struct {
+	db.OrderDetail
+	Product *db.Product `gorm:"foreignKey:ProductID"`
+}
+
+func (orderDetail) TableName() string { return "order_details" }
+
+type order struct {
+	db.Order
+	Details []orderDetail `gorm:"foreignKey:OrderID"`
+}
+
+func (order) TableName() string { return "orders" }
+
+const orderTotalsColumns = `orders.id, orders.shipped_date, orders.ship_name, orders.ship_city, orders.ship_country, ` +
+	`count(order_details.product_id)::int as products_count, sum(order_details.quantity)::int as quantity_sum, ` +
+	`sum(order_details.quantity * order_details.unit_price)::real as total_price`
+
+type Store struct {
+	db    *gorm.DB
+	sqlDB *sql.DB
+}
+
+func New(databaseUrl string, pool stdlibdb.PoolConfig) (*Store, error) {
+	g, err := gorm.Open(postgres.Open(databaseUrl), &gorm.Config{
+		PrepareStmt:            true,
+		SkipDefaultTransaction: true,
+		Logger:                 logger.Discard,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDB, err := g.DB()
+	if err != nil {
+		return nil, err
+	}
+
+	pool.Apply(sqlDB)
+
+	return &Store{db: g, sqlDB: sqlDB}, nil
+}
+
+func (s *Store) Ping(ctx context.Context) error {
+	return s.sqlDB.PingContext(ctx)
+}
+
+func (s *Store) Close() {
+	s.sqlDB.Close()
+}
+
+func (s *Store) Customers(ctx context.Context, arg db.CustomersParams) ([]db.Customer, error) {
+	items := []db.Customer{}
+	err := s.db.WithContext(ctx).Order("id asc").Limit(int(arg.Limit)).Offset(int(arg.Offset)).Find(&items).Error
+	return items, err
+}
+
+func (s *Store) CustomerById(ctx context.Context, id int32) (db.Customer, error) {
+	var i db.Customer
+	err := s.db.WithContext(ctx).Take(&i, id).Error
+	return i, notFound(err)
+}
+
+func (s *Store) SearchCustomer(ctx context.Context, arg db.SearchParams) ([]db.Customer, error) {
+	items := []db.Customer{}
+	err := search(s.db.WithContext(ctx), "company_name", string(arg.Mode), arg.Query(), arg.Limit, arg.Offset).Find(&items).Error
+	return items, err
+}
+
+func (s *Store) SearchCustomerHeadline(ctx context.Context, arg db.SearchParams) ([]db.SearchCustomerHeadlineRow, error) {
+	mode, query := string(arg.Mode), arg.Query()
+	items := []db.SearchCustomerHeadlineRow{}
+	err := search(s.db.WithContext(ctx).Model(&db.Customer{}), "company_name", mode, query, arg.Limit, arg.Offset).
+		Select("*, "+headline("company_name", mode), query).
+		Scan(&items).Error
+	return items, err
+}
+
+func (s *Store) Employees(ctx context.Context, arg db.EmployeesParams) ([]db.EmployeesRow, error) {
+	var employees []db.Employee
+	err := s.db.WithContext(ctx).Order("id asc").Limit(int(arg.Limit)).Offset(int(arg.Offset)).Find(&employees).Error
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.EmployeesRow, len(employees))
+	for i, e := range employees {
+		items[i] = db.EmployeeRow(e)
+	}
+	return items, nil
+}
+
+func (s *Store) EmployeeWithRecipient(ctx context.Context, id int32) ([]db.EmployeeWithRecipientRow, error) {
+	var employees []employee
+	err := s.db.WithContext(ctx).Preload("Recipient").Where("id = ?", id).Find(&employees).Error
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.EmployeeWithRecipientRow, len(employees))
+	for i, e := range employees {
+		var recipient db.RawJSON
+		if e.Recipient != nil {
+			if recipient, err = sonic.Marshal(db.EmployeeRow(*e.Recipient)); err != nil {
+				return nil, err
+			}
+		}
+
+		items[i] = db.EmployeeRow(e.Employee).WithRecipient(recipient)
+	}
+	return items, nil
+}
+
+func (s *Store) Suppliers(ctx context.Context, arg db.SuppliersParams) ([]db.Supplier, error) {
+	items := []db.Supplier{}
+	err := s.db.WithContext(ctx).Order("id asc").Limit(int(arg.Limit)).Offset(int(arg.Offset)).Find(&items).Error
+	return items, err
+}
+
+func (s *Store) SupplierById(ctx context.Context, id int32) (db.Supplier, error) {
+	var i db.Supplier
+	err := s.db.WithContext(ctx).Take(&i, id).Error
+	return i, notFound(err)
+}
+
+func (s *Store) Products(ctx context.Context, arg db.ProductsParams) ([]db.ProductsRow, error) {
+	var products []db.Product
+	err := s.db.WithContext(ctx).Order("id asc").Limit(int(arg.Limit)).Offset(int(arg.Offset)).Find(&products).Error
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.ProductsRow, len(products))
+	for i, p := range products {
+		items[i] = db.ProductRow(p)
+	}
+	return items, nil
+}
+
+func (s *Store) ProductWithSupplier(ctx context.Context, id int32) ([]db.ProductWithSupplierRow, error) {
+	var products []product
+	err := s.db.WithContext(ctx).Preload("Supplier").Where("id = ?", id).Find(&products).Error
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.ProductWithSupplierRow, len(products))
+	for i, p := range products {
+		var supplier db.RawJSON
+		if p.Supplier != nil {
+			if supplier, err = sonic.Marshal(p.Supplier); err != nil {
+				return nil, err
+			}
+		}
+
+		items[i] = db.ProductRow(p.Product).WithSupplier(supplier)
+	}
+	return items, nil
+}
+
+func (s *Store) SearchProduct(ctx context.Context, arg db.SearchParams) ([]db.SearchProductRow, error) {
+	var products []db.Product
+	err := search(s.db.WithContext(ctx), "name", string(arg.Mode), arg.Query(), arg.Limit, arg.Offset).Find(&products).Error
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.SearchProductRow, len(products))
+	for i, p := range products {
+		items[i] = db.SearchProductRow(db.ProductRow(p))
+	}
+	return items, nil
+}
+
+func (s *Store) SearchProductHeadline(ctx context.Context, arg db.SearchParams) ([]db.SearchProductHeadlineRow, error) {
+	mode, query := string(arg.Mode), arg.Query()
+	items := []db.SearchProductHeadlineRow{}
+	err := search(s.db.WithContext(ctx).Model(&db.Product{}), "name", mode, query, arg.Limit, arg.Offset).
+		Select("*, qt_per_unit as quantity_per_unit, "+headline("name", mode), query).
+		Scan(&items).Error
+	return items, err
+}
+
+func (s *Store) OrdersWithDetails(ctx context.Context, arg db.OrdersWithDetailsParams) ([]db.OrdersWithDetailsRow, error) {
+	items := []db.OrdersWithDetailsRow{}
+	err := s.db.WithContext(ctx).
+		Table("orders").
+		Select(orderTotalsColumns).
+		Joins("left join order_details on order_details.order_id = orders.id").
+		Group("orders.id").
+		Order("orders.id asc").
+		Limit(int(arg.Limit)).
+		Offset(int(arg.Offset)).
+		Scan(&items).Error
+	return items, err
+}
+
+func (s *Store) OrderWithDetails(ctx context.Context, id int32) ([]db.OrderWithDetailsRow, error) {
+	items := []db.OrderWithDetailsRow{}
+	err := s.db.WithContext(ctx).
+		Table("orders").
+		Select(orderTotalsColumns).
+		Joins("left join order_details on order_details.order_id = orders.id").
+		Where("orders.id = ?", id).
+		Group("orders.id").
+		Order("orders.id asc").
+		Scan(&items).Error
+	return items, err
+}
+
+func (s *Store) OrderWithDetailsAndProducts(ctx context.Context, id int32) ([]db.OrderWithDetailsAndProductsRow, error) {
+	var orders []order
+	err := s.db.WithContext(ctx).Preload("Details.Product").Where("id = ?", id).Find(&orders).Error
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.OrderWithDetailsAndProductsRow, len(orders))
+	for i, o := range orders {
+		details := make([]db.OrderDetailWithProduct, len(o.Details))
+		for j, d := range o.Details {
+			details[j].OrderDetail = d.OrderDetail
+			if d.Product != nil {
+				p := db.ProductRow(*d.Product)
+				details[j].Product = &p
+			}
+		}
+
+		raw, err := sonic.Marshal(details)
+		if err != nil {
+			return nil, err
+		}
+
+		items[i] = o.WithDetails(raw)
+	}
+	return items, nil
+}
+
+// search filters, ranks and paginates a full-text query over column, the same
+// way the sqlc search queries do.
+func search(tx *gorm.DB, column, mode, query string, limit, offset int32) *gorm.DB {
+	vector := fmt.Sprintf("to_tsvector('english', %s)", column)
+	tsquery := fmt.Sprintf("%s('english', ?)", db.TSQueryFunc(mode))
+
+	return tx.
+		Where(vector+" @@ "+tsquery, query).
+		Order(clause.OrderBy{Expression: clause.Expr{
+			SQL:  fmt.Sprintf("ts_rank(%s, %s) desc, id asc", vector, tsquery),
+			Vars: []any{query},
+		}}).
+		Limit(int(limit)).
+		Offset(int(offset))
+}
+
+func headline(column, mode string) string {
+	return fmt.Sprintf("ts_headline('english', %s, %s('english', ?))::text as headline", column, db.TSQueryFunc(mode))
+}
+
+func notFound(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return sql.ErrNoRows
+	}
+	return err
+}
+
+var (
+	_ db.Store       = (*Store)(nil)
+	_ db.Highlighter = (*Store)(nil)
+	_ db.Pinger      = (*Store)(nil)
+)
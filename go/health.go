@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"perf-drizzle/go/db"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// readiness answers /readyz. The server only listens once the store is open,
+// warm-up included, so what is left to go wrong is the database going away,
+// a database nobody has migrated and seeded yet, or a shutdown under way.
+type readiness struct {
+	store db.Store
+	// databaseUrl is empty for the backends that do not serve from Postgres,
+	// whose schema is then not checked.
+	databaseUrl string
+	warmUp      string
+	stopping    atomic.Bool
+
+	mu       sync.Mutex
+	schema   []db.Check
+	passed   bool
+	checked  time.Time
+	checking bool
+}
+
+// schemaRetry is how long /readyz reports a failed schema check before it
+// connects to check again.
+const schemaRetry = 5 * time.Second
+
+type readyReport struct {
+	Ready  bool       `json:"ready"`
+	Checks []db.Check `json:"checks"`
+}
+
+func (r *readiness) report(ctx context.Context) readyReport {
+	checks := []db.Check{{Name: "shutdown", OK: !r.stopping.Load()}}
+	if !checks[0].OK {
+		checks[0].Detail = "shutting down"
+	}
+
+	if pinger, ok := r.store.(db.Pinger); ok {
+		check := db.Check{Name: "ping", OK: true}
+		if err := pinger.Ping(ctx); err != nil {
+			check = db.Check{Name: "ping", Detail: err.Error()}
+		}
+		checks = append(checks, check)
+	}
+
+	warmUp := db.Check{Name: "warm-up", OK: true, Detail: r.warmUp}
+	if warmUp.Detail == "" {
+		warmUp.Detail = "off"
+	}
+	checks = append(checks, warmUp)
+
+	if r.databaseUrl != "" {
+		checks = append(checks, r.schemaChecks(ctx)...)
+	}
+
+	report := readyReport{Ready: true, Checks: checks}
+	for _, check := range checks {
+		report.Ready = report.Ready && check.OK
+	}
+
+	return report
+}
+
+// schemaChecks checks the schema until it passes once, since migrations are
+// not rolled back under a running server. A failure is reported for
+// schemaRetry before the next check, and only one check runs at a time, so a
+// slow database never holds up the probes or takes a connection per probe.
+func (r *readiness) schemaChecks(ctx context.Context) []db.Check {
+	r.mu.Lock()
+	if r.passed || r.checking || r.schema != nil && time.Since(r.checked) < schemaRetry {
+		checks := r.schema
+		r.mu.Unlock()
+
+		if checks == nil {
+			return []db.Check{{Name: "schema", Detail: "first check still running"}}
+		}
+		return checks
+	}
+	r.checking = true
+	r.mu.Unlock()
+
+	checks := db.CheckSchema(ctx, r.databaseUrl)
+	passed := true
+	for _, check := range checks {
+		passed = passed && check.OK
+	}
+
+	r.mu.Lock()
+	r.schema, r.passed, r.checked, r.checking = checks, passed, time.Now(), false
+	r.mu.Unlock()
+
+	return checks
+}
+
+// stop makes /readyz answer 503 from now on. shutdown calls it a drain delay
+// before it closes the listener, for load balancers to notice.
+func (r *readiness) stop() {
+	r.stopping.Store(true)
+}
+
+// routes registers /healthz, which only says the process is up, and /readyz,
+// which turns 503 when a check fails, from the moment shutdown begins.
+func (r *readiness) routes(app *fiber.App) {
+	app.Get("/healthz", func(c fiber.Ctx) error {
+		return c.JSON(map[string]string{"status": "ok"})
+	})
+
+	app.Get("/readyz", func(c fiber.Ctx) error {
+		ctx, cancel := context.WithTimeout(c.Context(), 5*time.Second)
+		defer cancel()
+
+		report := r.report(ctx)
+		if !report.Ready {
+			c.Status(fiber.StatusServiceUnavailable)
+		}
+
+		return c.JSON(report)
+	})
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSchemaChecksRetry(t *testing.T) {
+	r := &readiness{databaseUrl: stalled(t)}
+
+	failed := func(t *testing.T, ctx context.Context) time.Duration {
+		t.Helper()
+
+		start := time.Now()
+		checks := r.schemaChecks(ctx)
+		if len(checks) == 0 || checks[0].OK {
+			t.Fatalf("checks = %+v, want a failure", checks)
+		}
+		return time.Since(start)
+	}
+
+	// The stalled server holds every check that connects until its deadline,
+	// so a probe that returns well before it did not connect.
+	wait := func(t *testing.T) context.Context {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		t.Cleanup(cancel)
+		return ctx
+	}
+
+	failed(t, shortContext(t))
+
+	if took := failed(t, wait(t)); took > time.Second {
+		t.Errorf("a probe within schemaRetry of a failure connected again (took %v)", took)
+	}
+
+	r.mu.Lock()
+	r.checked = r.checked.Add(-schemaRetry)
+	r.mu.Unlock()
+
+	if took := failed(t, shortContext(t)); took < 50*time.Millisecond {
+		t.Errorf("a probe after schemaRetry reported the old failure (took %v)", took)
+	}
+}
+
+func TestSchemaChecksConcurrent(t *testing.T) {
+	r := &readiness{databaseUrl: stalled(t)}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		r.schemaChecks(shortContext(t))
+	}()
+
+	for {
+		r.mu.Lock()
+		checking := r.checking
+		r.mu.Unlock()
+		if checking {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// The mutex is free while the first check waits on the server, and the
+	// probes meanwhile report that it is running.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	start := time.Now()
+	checks := r.schemaChecks(ctx)
+	if took := time.Since(start); took > 25*time.Millisecond {
+		t.Errorf("a probe waited %v on the running check", took)
+	}
+	if len(checks) != 1 || checks[0].OK || checks[0].Detail != "first check still running" {
+		t.Errorf("checks = %+v", checks)
+	}
+
+	<-done
+}
@@ -0,0 +1,22 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package model
+
+type Customers struct {
+	ID           int32 `sql:"primary_key"`
+	CompanyName  string
+	ContactName  string
+	ContactTitle string
+	Address      string
+	City         string
+	PostalCode   *string
+	Region       *string
+	Country      string
+	Phone        string
+	Fax          *string
+}
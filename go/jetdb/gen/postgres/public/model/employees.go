@@ -0,0 +1,30 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package model
+
+import (
+	"time"
+)
+
+type Employees struct {
+	ID              int32 `sql:"primary_key"`
+	LastName        string
+	FirstName       *string
+	Title           string
+	TitleOfCourtesy string
+	BirthDate       time.Time
+	HireDate        time.Time
+	Address         string
+	City            string
+	PostalCode      string
+	Country         string
+	HomePhone       string
+	Extension       int32
+	Notes           string
+	RecipientID     *int32
+}
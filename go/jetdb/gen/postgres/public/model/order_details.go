@@ -0,0 +1,16 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package model
+
+type OrderDetails struct {
+	UnitPrice float64
+	Quantity  int32
+	Discount  float64
+	OrderID   int32
+	ProductID int32
+}
@@ -0,0 +1,28 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package model
+
+import (
+	"time"
+)
+
+type Orders struct {
+	ID             int32 `sql:"primary_key"`
+	OrderDate      time.Time
+	RequiredDate   time.Time
+	ShippedDate    *time.Time
+	ShipVia        int32
+	Freight        float64
+	ShipName       string
+	ShipCity       string
+	ShipRegion     *string
+	ShipPostalCode *string
+	ShipCountry    string
+	CustomerID     int32
+	EmployeeID     int32
+}
@@ -0,0 +1,20 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package model
+
+type Products struct {
+	ID           int32 `sql:"primary_key"`
+	Name         string
+	QtPerUnit    string
+	UnitPrice    float64
+	UnitsInStock int32
+	UnitsOnOrder int32
+	ReorderLevel int32
+	Discontinued int32
+	SupplierID   int32
+}
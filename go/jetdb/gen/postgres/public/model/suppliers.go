@@ -0,0 +1,21 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package model
+
+type Suppliers struct {
+	ID           int32 `sql:"primary_key"`
+	CompanyName  string
+	ContactName  string
+	ContactTitle string
+	Address      string
+	City         string
+	Region       *string
+	PostalCode   string
+	Country      string
+	Phone        string
+}
@@ -0,0 +1,108 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package table
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var Customers = newCustomersTable("public", "customers", "")
+
+type customersTable struct {
+	postgres.Table
+
+	// Columns
+	ID           postgres.ColumnInteger
+	CompanyName  postgres.ColumnString
+	ContactName  postgres.ColumnString
+	ContactTitle postgres.ColumnString
+	Address      postgres.ColumnString
+	City         postgres.ColumnString
+	PostalCode   postgres.ColumnString
+	Region       postgres.ColumnString
+	Country      postgres.ColumnString
+	Phone        postgres.ColumnString
+	Fax          postgres.ColumnString
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+	DefaultColumns postgres.ColumnList
+}
+
+type CustomersTable struct {
+	customersTable
+
+	EXCLUDED customersTable
+}
+
+// AS creates new CustomersTable with assigned alias
+func (a CustomersTable) AS(alias string) *CustomersTable {
+	return newCustomersTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new CustomersTable with assigned schema name
+func (a CustomersTable) FromSchema(schemaName string) *CustomersTable {
+	return newCustomersTable(schemaName, a.TableName(), a.Alias())
+}
+
+// WithPrefix creates new CustomersTable with assigned table prefix
+func (a CustomersTable) WithPrefix(prefix string) *CustomersTable {
+	return newCustomersTable(a.SchemaName(), prefix+a.TableName(), a.TableName())
+}
+
+// WithSuffix creates new CustomersTable with assigned table suffix
+func (a CustomersTable) WithSuffix(suffix string) *CustomersTable {
+	return newCustomersTable(a.SchemaName(), a.TableName()+suffix, a.TableName())
+}
+
+func newCustomersTable(schemaName, tableName, alias string) *CustomersTable {
+	return &CustomersTable{
+		customersTable: newCustomersTableImpl(schemaName, tableName, alias),
+		EXCLUDED:       newCustomersTableImpl("", "excluded", ""),
+	}
+}
+
+func newCustomersTableImpl(schemaName, tableName, alias string) customersTable {
+	var (
+		IDColumn           = postgres.IntegerColumn("id")
+		CompanyNameColumn  = postgres.StringColumn("company_name")
+		ContactNameColumn  = postgres.StringColumn("contact_name")
+		ContactTitleColumn = postgres.StringColumn("contact_title")
+		AddressColumn      = postgres.StringColumn("address")
+		CityColumn         = postgres.StringColumn("city")
+		PostalCodeColumn   = postgres.StringColumn("postal_code")
+		RegionColumn       = postgres.StringColumn("region")
+		CountryColumn      = postgres.StringColumn("country")
+		PhoneColumn        = postgres.StringColumn("phone")
+		FaxColumn          = postgres.StringColumn("fax")
+		allColumns         = postgres.ColumnList{IDColumn, CompanyNameColumn, ContactNameColumn, ContactTitleColumn, AddressColumn, CityColumn, PostalCodeColumn, RegionColumn, CountryColumn, PhoneColumn, FaxColumn}
+		mutableColumns     = postgres.ColumnList{CompanyNameColumn, ContactNameColumn, ContactTitleColumn, AddressColumn, CityColumn, PostalCodeColumn, RegionColumn, CountryColumn, PhoneColumn, FaxColumn}
+		defaultColumns     = postgres.ColumnList{IDColumn}
+	)
+
+	return customersTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		ID:           IDColumn,
+		CompanyName:  CompanyNameColumn,
+		ContactName:  ContactNameColumn,
+		ContactTitle: ContactTitleColumn,
+		Address:      AddressColumn,
+		City:         CityColumn,
+		PostalCode:   PostalCodeColumn,
+		Region:       RegionColumn,
+		Country:      CountryColumn,
+		Phone:        PhoneColumn,
+		Fax:          FaxColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+		DefaultColumns: defaultColumns,
+	}
+}
@@ -0,0 +1,120 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package table
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var Employees = newEmployeesTable("public", "employees", "")
+
+type employeesTable struct {
+	postgres.Table
+
+	// Columns
+	ID              postgres.ColumnInteger
+	LastName        postgres.ColumnString
+	FirstName       postgres.ColumnString
+	Title           postgres.ColumnString
+	TitleOfCourtesy postgres.ColumnString
+	BirthDate       postgres.ColumnDate
+	HireDate        postgres.ColumnDate
+	Address         postgres.ColumnString
+	City            postgres.ColumnString
+	PostalCode      postgres.ColumnString
+	Country         postgres.ColumnString
+	HomePhone       postgres.ColumnString
+	Extension       postgres.ColumnInteger
+	Notes           postgres.ColumnString
+	RecipientID     postgres.ColumnInteger
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+	DefaultColumns postgres.ColumnList
+}
+
+type EmployeesTable struct {
+	employeesTable
+
+	EXCLUDED employeesTable
+}
+
+// AS creates new EmployeesTable with assigned alias
+func (a EmployeesTable) AS(alias string) *EmployeesTable {
+	return newEmployeesTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new EmployeesTable with assigned schema name
+func (a EmployeesTable) FromSchema(schemaName string) *EmployeesTable {
+	return newEmployeesTable(schemaName, a.TableName(), a.Alias())
+}
+
+// WithPrefix creates new EmployeesTable with assigned table prefix
+func (a EmployeesTable) WithPrefix(prefix string) *EmployeesTable {
+	return newEmployeesTable(a.SchemaName(), prefix+a.TableName(), a.TableName())
+}
+
+// WithSuffix creates new EmployeesTable with assigned table suffix
+func (a EmployeesTable) WithSuffix(suffix string) *EmployeesTable {
+	return newEmployeesTable(a.SchemaName(), a.TableName()+suffix, a.TableName())
+}
+
+func newEmployeesTable(schemaName, tableName, alias string) *EmployeesTable {
+	return &EmployeesTable{
+		employeesTable: newEmployeesTableImpl(schemaName, tableName, alias),
+		EXCLUDED:       newEmployeesTableImpl("", "excluded", ""),
+	}
+}
+
+func newEmployeesTableImpl(schemaName, tableName, alias string) employeesTable {
+	var (
+		IDColumn              = postgres.IntegerColumn("id")
+		LastNameColumn        = postgres.StringColumn("last_name")
+		FirstNameColumn       = postgres.StringColumn("first_name")
+		TitleColumn           = postgres.StringColumn("title")
+		TitleOfCourtesyColumn = postgres.StringColumn("title_of_courtesy")
+		BirthDateColumn       = postgres.DateColumn("birth_date")
+		HireDateColumn        = postgres.DateColumn("hire_date")
+		AddressColumn         = postgres.StringColumn("address")
+		CityColumn            = postgres.StringColumn("city")
+		PostalCodeColumn      = postgres.StringColumn("postal_code")
+		CountryColumn         = postgres.StringColumn("country")
+		HomePhoneColumn       = postgres.StringColumn("home_phone")
+		ExtensionColumn       = postgres.IntegerColumn("extension")
+		NotesColumn           = postgres.StringColumn("notes")
+		RecipientIDColumn     = postgres.IntegerColumn("recipient_id")
+		allColumns            = postgres.ColumnList{IDColumn, LastNameColumn, FirstNameColumn, TitleColumn, TitleOfCourtesyColumn, BirthDateColumn, HireDateColumn, AddressColumn, CityColumn, PostalCodeColumn, CountryColumn, HomePhoneColumn, ExtensionColumn, NotesColumn, RecipientIDColumn}
+		mutableColumns        = postgres.ColumnList{LastNameColumn, FirstNameColumn, TitleColumn, TitleOfCourtesyColumn, BirthDateColumn, HireDateColumn, AddressColumn, CityColumn, PostalCodeColumn, CountryColumn, HomePhoneColumn, ExtensionColumn, NotesColumn, RecipientIDColumn}
+		defaultColumns        = postgres.ColumnList{IDColumn}
+	)
+
+	return employeesTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		ID:              IDColumn,
+		LastName:        LastNameColumn,
+		FirstName:       FirstNameColumn,
+		Title:           TitleColumn,
+		TitleOfCourtesy: TitleOfCourtesyColumn,
+		BirthDate:       BirthDateColumn,
+		HireDate:        HireDateColumn,
+		Address:         AddressColumn,
+		City:            CityColumn,
+		PostalCode:      PostalCodeColumn,
+		Country:         CountryColumn,
+		HomePhone:       HomePhoneColumn,
+		Extension:       ExtensionColumn,
+		Notes:           NotesColumn,
+		RecipientID:     RecipientIDColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+		DefaultColumns: defaultColumns,
+	}
+}
@@ -0,0 +1,90 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package table
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var OrderDetails = newOrderDetailsTable("public", "order_details", "")
+
+type orderDetailsTable struct {
+	postgres.Table
+
+	// Columns
+	UnitPrice postgres.ColumnFloat
+	Quantity  postgres.ColumnInteger
+	Discount  postgres.ColumnFloat
+	OrderID   postgres.ColumnInteger
+	ProductID postgres.ColumnInteger
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+	DefaultColumns postgres.ColumnList
+}
+
+type OrderDetailsTable struct {
+	orderDetailsTable
+
+	EXCLUDED orderDetailsTable
+}
+
+// AS creates new OrderDetailsTable with assigned alias
+func (a OrderDetailsTable) AS(alias string) *OrderDetailsTable {
+	return newOrderDetailsTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new OrderDetailsTable with assigned schema name
+func (a OrderDetailsTable) FromSchema(schemaName string) *OrderDetailsTable {
+	return newOrderDetailsTable(schemaName, a.TableName(), a.Alias())
+}
+
+// WithPrefix creates new OrderDetailsTable with assigned table prefix
+func (a OrderDetailsTable) WithPrefix(prefix string) *OrderDetailsTable {
+	return newOrderDetailsTable(a.SchemaName(), prefix+a.TableName(), a.TableName())
+}
+
+// WithSuffix creates new OrderDetailsTable with assigned table suffix
+func (a OrderDetailsTable) WithSuffix(suffix string) *OrderDetailsTable {
+	return newOrderDetailsTable(a.SchemaName(), a.TableName()+suffix, a.TableName())
+}
+
+func newOrderDetailsTable(schemaName, tableName, alias string) *OrderDetailsTable {
+	return &OrderDetailsTable{
+		orderDetailsTable: newOrderDetailsTableImpl(schemaName, tableName, alias),
+		EXCLUDED:          newOrderDetailsTableImpl("", "excluded", ""),
+	}
+}
+
+func newOrderDetailsTableImpl(schemaName, tableName, alias string) orderDetailsTable {
+	var (
+		UnitPriceColumn = postgres.FloatColumn("unit_price")
+		QuantityColumn  = postgres.IntegerColumn("quantity")
+		DiscountColumn  = postgres.FloatColumn("discount")
+		OrderIDColumn   = postgres.IntegerColumn("order_id")
+		ProductIDColumn = postgres.IntegerColumn("product_id")
+		allColumns      = postgres.ColumnList{UnitPriceColumn, QuantityColumn, DiscountColumn, OrderIDColumn, ProductIDColumn}
+		mutableColumns  = postgres.ColumnList{UnitPriceColumn, QuantityColumn, DiscountColumn, OrderIDColumn, ProductIDColumn}
+		defaultColumns  = postgres.ColumnList{}
+	)
+
+	return orderDetailsTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		UnitPrice: UnitPriceColumn,
+		Quantity:  QuantityColumn,
+		Discount:  DiscountColumn,
+		OrderID:   OrderIDColumn,
+		ProductID: ProductIDColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+		DefaultColumns: defaultColumns,
+	}
+}
@@ -0,0 +1,114 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package table
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var Orders = newOrdersTable("public", "orders", "")
+
+type ordersTable struct {
+	postgres.Table
+
+	// Columns
+	ID             postgres.ColumnInteger
+	OrderDate      postgres.ColumnDate
+	RequiredDate   postgres.ColumnDate
+	ShippedDate    postgres.ColumnDate
+	ShipVia        postgres.ColumnInteger
+	Freight        postgres.ColumnFloat
+	ShipName       postgres.ColumnString
+	ShipCity       postgres.ColumnString
+	ShipRegion     postgres.ColumnString
+	ShipPostalCode postgres.ColumnString
+	ShipCountry    postgres.ColumnString
+	CustomerID     postgres.ColumnInteger
+	EmployeeID     postgres.ColumnInteger
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+	DefaultColumns postgres.ColumnList
+}
+
+type OrdersTable struct {
+	ordersTable
+
+	EXCLUDED ordersTable
+}
+
+// AS creates new OrdersTable with assigned alias
+func (a OrdersTable) AS(alias string) *OrdersTable {
+	return newOrdersTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new OrdersTable with assigned schema name
+func (a OrdersTable) FromSchema(schemaName string) *OrdersTable {
+	return newOrdersTable(schemaName, a.TableName(), a.Alias())
+}
+
+// WithPrefix creates new OrdersTable with assigned table prefix
+func (a OrdersTable) WithPrefix(prefix string) *OrdersTable {
+	return newOrdersTable(a.SchemaName(), prefix+a.TableName(), a.TableName())
+}
+
+// WithSuffix creates new OrdersTable with assigned table suffix
+func (a OrdersTable) WithSuffix(suffix string) *OrdersTable {
+	return newOrdersTable(a.SchemaName(), a.TableName()+suffix, a.TableName())
+}
+
+func newOrdersTable(schemaName, tableName, alias string) *OrdersTable {
+	return &OrdersTable{
+		ordersTable: newOrdersTableImpl(schemaName, tableName, alias),
+		EXCLUDED:    newOrdersTableImpl("", "excluded", ""),
+	}
+}
+
+func newOrdersTableImpl(schemaName, tableName, alias string) ordersTable {
+	var (
+		IDColumn             = postgres.IntegerColumn("id")
+		OrderDateColumn      = postgres.DateColumn("order_date")
+		RequiredDateColumn   = postgres.DateColumn("required_date")
+		ShippedDateColumn    = postgres.DateColumn("shipped_date")
+		ShipViaColumn        = postgres.IntegerColumn("ship_via")
+		FreightColumn        = postgres.FloatColumn("freight")
+		ShipNameColumn       = postgres.StringColumn("ship_name")
+		ShipCityColumn       = postgres.StringColumn("ship_city")
+		ShipRegionColumn     = postgres.StringColumn("ship_region")
+		ShipPostalCodeColumn = postgres.StringColumn("ship_postal_code")
+		ShipCountryColumn    = postgres.StringColumn("ship_country")
+		CustomerIDColumn     = postgres.IntegerColumn("customer_id")
+		EmployeeIDColumn     = postgres.IntegerColumn("employee_id")
+		allColumns           = postgres.ColumnList{IDColumn, OrderDateColumn, RequiredDateColumn, ShippedDateColumn, ShipViaColumn, FreightColumn, ShipNameColumn, ShipCityColumn, ShipRegionColumn, ShipPostalCodeColumn, ShipCountryColumn, CustomerIDColumn, EmployeeIDColumn}
+		mutableColumns       = postgres.ColumnList{OrderDateColumn, RequiredDateColumn, ShippedDateColumn, ShipViaColumn, FreightColumn, ShipNameColumn, ShipCityColumn, ShipRegionColumn, ShipPostalCodeColumn, ShipCountryColumn, CustomerIDColumn, EmployeeIDColumn}
+		defaultColumns       = postgres.ColumnList{IDColumn}
+	)
+
+	return ordersTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		ID:             IDColumn,
+		OrderDate:      OrderDateColumn,
+		RequiredDate:   RequiredDateColumn,
+		ShippedDate:    ShippedDateColumn,
+		ShipVia:        ShipViaColumn,
+		Freight:        FreightColumn,
+		ShipName:       ShipNameColumn,
+		ShipCity:       ShipCityColumn,
+		ShipRegion:     ShipRegionColumn,
+		ShipPostalCode: ShipPostalCodeColumn,
+		ShipCountry:    ShipCountryColumn,
+		CustomerID:     CustomerIDColumn,
+		EmployeeID:     EmployeeIDColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+		DefaultColumns: defaultColumns,
+	}
+}
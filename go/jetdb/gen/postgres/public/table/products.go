@@ -0,0 +1,102 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package table
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var Products = newProductsTable("public", "products", "")
+
+type productsTable struct {
+	postgres.Table
+
+	// Columns
+	ID           postgres.ColumnInteger
+	Name         postgres.ColumnString
+	QtPerUnit    postgres.ColumnString
+	UnitPrice    postgres.ColumnFloat
+	UnitsInStock postgres.ColumnInteger
+	UnitsOnOrder postgres.ColumnInteger
+	ReorderLevel postgres.ColumnInteger
+	Discontinued postgres.ColumnInteger
+	SupplierID   postgres.ColumnInteger
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+	DefaultColumns postgres.ColumnList
+}
+
+type ProductsTable struct {
+	productsTable
+
+	EXCLUDED productsTable
+}
+
+// AS creates new ProductsTable with assigned alias
+func (a ProductsTable) AS(alias string) *ProductsTable {
+	return newProductsTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new ProductsTable with assigned schema name
+func (a ProductsTable) FromSchema(schemaName string) *ProductsTable {
+	return newProductsTable(schemaName, a.TableName(), a.Alias())
+}
+
+// WithPrefix creates new ProductsTable with assigned table prefix
+func (a ProductsTable) WithPrefix(prefix string) *ProductsTable {
+	return newProductsTable(a.SchemaName(), prefix+a.TableName(), a.TableName())
+}
+
+// WithSuffix creates new ProductsTable with assigned table suffix
+func (a ProductsTable) WithSuffix(suffix string) *ProductsTable {
+	return newProductsTable(a.SchemaName(), a.TableName()+suffix, a.TableName())
+}
+
+func newProductsTable(schemaName, tableName, alias string) *ProductsTable {
+	return &ProductsTable{
+		productsTable: newProductsTableImpl(schemaName, tableName, alias),
+		EXCLUDED:      newProductsTableImpl("", "excluded", ""),
+	}
+}
+
+func newProductsTableImpl(schemaName, tableName, alias string) productsTable {
+	var (
+		IDColumn           = postgres.IntegerColumn("id")
+		NameColumn         = postgres.StringColumn("name")
+		QtPerUnitColumn    = postgres.StringColumn("qt_per_unit")
+		UnitPriceColumn    = postgres.FloatColumn("unit_price")
+		UnitsInStockColumn = postgres.IntegerColumn("units_in_stock")
+		UnitsOnOrderColumn = postgres.IntegerColumn("units_on_order")
+		ReorderLevelColumn = postgres.IntegerColumn("reorder_level")
+		DiscontinuedColumn = postgres.IntegerColumn("discontinued")
+		SupplierIDColumn   = postgres.IntegerColumn("supplier_id")
+		allColumns         = postgres.ColumnList{IDColumn, NameColumn, QtPerUnitColumn, UnitPriceColumn, UnitsInStockColumn, UnitsOnOrderColumn, ReorderLevelColumn, DiscontinuedColumn, SupplierIDColumn}
+		mutableColumns     = postgres.ColumnList{NameColumn, QtPerUnitColumn, UnitPriceColumn, UnitsInStockColumn, UnitsOnOrderColumn, ReorderLevelColumn, DiscontinuedColumn, SupplierIDColumn}
+		defaultColumns     = postgres.ColumnList{IDColumn, SupplierIDColumn}
+	)
+
+	return productsTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		ID:           IDColumn,
+		Name:         NameColumn,
+		QtPerUnit:    QtPerUnitColumn,
+		UnitPrice:    UnitPriceColumn,
+		UnitsInStock: UnitsInStockColumn,
+		UnitsOnOrder: UnitsOnOrderColumn,
+		ReorderLevel: ReorderLevelColumn,
+		Discontinued: DiscontinuedColumn,
+		SupplierID:   SupplierIDColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+		DefaultColumns: defaultColumns,
+	}
+}
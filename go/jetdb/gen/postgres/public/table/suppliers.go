@@ -0,0 +1,105 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package table
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var Suppliers = newSuppliersTable("public", "suppliers", "")
+
+type suppliersTable struct {
+	postgres.Table
+
+	// Columns
+	ID           postgres.ColumnInteger
+	CompanyName  postgres.ColumnString
+	ContactName  postgres.ColumnString
+	ContactTitle postgres.ColumnString
+	Address      postgres.ColumnString
+	City         postgres.ColumnString
+	Region       postgres.ColumnString
+	PostalCode   postgres.ColumnString
+	Country      postgres.ColumnString
+	Phone        postgres.ColumnString
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+	DefaultColumns postgres.ColumnList
+}
+
+type SuppliersTable struct {
+	suppliersTable
+
+	EXCLUDED suppliersTable
+}
+
+// AS creates new SuppliersTable with assigned alias
+func (a SuppliersTable) AS(alias string) *SuppliersTable {
+	return newSuppliersTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new SuppliersTable with assigned schema name
+func (a SuppliersTable) FromSchema(schemaName string) *SuppliersTable {
+	return newSuppliersTable(schemaName, a.TableName(), a.Alias())
+}
+
+// WithPrefix creates new SuppliersTable with assigned table prefix
+func (a SuppliersTable) WithPrefix(prefix string) *SuppliersTable {
+	return newSuppliersTable(a.SchemaName(), prefix+a.TableName(), a.TableName())
+}
+
+// WithSuffix creates new SuppliersTable with assigned table suffix
+func (a SuppliersTable) WithSuffix(suffix string) *SuppliersTable {
+	return newSuppliersTable(a.SchemaName(), a.TableName()+suffix, a.TableName())
+}
+
+func newSuppliersTable(schemaName, tableName, alias string) *SuppliersTable {
+	return &SuppliersTable{
+		suppliersTable: newSuppliersTableImpl(schemaName, tableName, alias),
+		EXCLUDED:       newSuppliersTableImpl("", "excluded", ""),
+	}
+}
+
+func newSuppliersTableImpl(schemaName, tableName, alias string) suppliersTable {
+	var (
+		IDColumn           = postgres.IntegerColumn("id")
+		CompanyNameColumn  = postgres.StringColumn("company_name")
+		ContactNameColumn  = postgres.StringColumn("contact_name")
+		ContactTitleColumn = postgres.StringColumn("contact_title")
+		AddressColumn      = postgres.StringColumn("address")
+		CityColumn         = postgres.StringColumn("city")
+		RegionColumn       = postgres.StringColumn("region")
+		PostalCodeColumn   = postgres.StringColumn("postal_code")
+		CountryColumn      = postgres.StringColumn("country")
+		PhoneColumn        = postgres.StringColumn("phone")
+		allColumns         = postgres.ColumnList{IDColumn, CompanyNameColumn, ContactNameColumn, ContactTitleColumn, AddressColumn, CityColumn, RegionColumn, PostalCodeColumn, CountryColumn, PhoneColumn}
+		mutableColumns     = postgres.ColumnList{CompanyNameColumn, ContactNameColumn, ContactTitleColumn, AddressColumn, CityColumn, RegionColumn, PostalCodeColumn, CountryColumn, PhoneColumn}
+		defaultColumns     = postgres.ColumnList{IDColumn}
+	)
+
+	return suppliersTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		ID:           IDColumn,
+		CompanyName:  CompanyNameColumn,
+		ContactName:  ContactNameColumn,
+		ContactTitle: ContactTitleColumn,
+		Address:      AddressColumn,
+		City:         CityColumn,
+		Region:       RegionColumn,
+		PostalCode:   PostalCodeColumn,
+		Country:      CountryColumn,
+		Phone:        PhoneColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+		DefaultColumns: defaultColumns,
+	}
+}
@@ -0,0 +1,19 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package table
+
+// UseSchema sets a new schema name for all generated table SQL builder types. It is recommended to invoke
+// this method only once at the beginning of the program.
+func UseSchema(schema string) {
+	Customers = Customers.FromSchema(schema)
+	Employees = Employees.FromSchema(schema)
+	OrderDetails = OrderDetails.FromSchema(schema)
+	Orders = Orders.FromSchema(schema)
+	Products = Products.FromSchema(schema)
+	Suppliers = Suppliers.FromSchema(schema)
+}
@@ -0,0 +1,535 @@
+// Package jetdb implements db.Store with go-jet, building the lateral
+// row_to_json queries of go/db/queries.sql from the generated, type-safe table
+// definitions in ./gen. Regenerate them from a migrated database with:
+//
+//	go run github.com/go-jet/jet/v2/cmd/jet@v2.14.0 -dsn=$DATABASE_URL -schema=public -path=./go/jetdb/gen
+package jetdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"perf-drizzle/go/db"
+	"perf-drizzle/go/jetdb/gen/postgres/public/model"
+	. "perf-drizzle/go/jetdb/gen/postgres/public/table"
+	"perf-drizzle/go/stdlibdb"
+
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+type Store struct {
+	db *sql.DB
+}
+
+func New(databaseUrl string, pool stdlibdb.PoolConfig) (*Store, error) {
+	sqlDB, err := sql.Open("pgx", databaseUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	pool.Apply(sqlDB)
+
+	return &Store{db: sqlDB}, nil
+}
+
+func (s *Store) Ping(ctx context.Context) error {
+	return s.db.PingContext(ctx)
+}
+
+func (s *Store) Close() {
+	s.db.Close()
+}
+
+func (s *Store) Customers(ctx context.Context, arg db.CustomersParams) ([]db.Customer, error) {
+	var dest []model.Customers
+	err := SELECT(Customers.AllColumns).
+		FROM(Customers).
+		ORDER_BY(Customers.ID.ASC()).
+		LIMIT(int64(arg.Limit)).
+		OFFSET(int64(arg.Offset)).
+		QueryContext(ctx, s.db, &dest)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.Customer, len(dest))
+	for i, c := range dest {
+		items[i] = db.Customer(c)
+	}
+	return items, nil
+}
+
+func (s *Store) CustomerById(ctx context.Context, id int32) (db.Customer, error) {
+	var dest model.Customers
+	err := SELECT(Customers.AllColumns).
+		FROM(Customers).
+		WHERE(Customers.ID.EQ(Int32(id))).
+		QueryContext(ctx, s.db, &dest)
+	return db.Customer(dest), notFound(err)
+}
+
+func (s *Store) SearchCustomer(ctx context.Context, arg db.SearchParams) ([]db.Customer, error) {
+	var dest []model.Customers
+	err := search(SELECT(Customers.AllColumns).FROM(Customers), Customers.CompanyName, Customers.ID, string(arg.Mode), arg.Query(), arg.Limit, arg.Offset).
+		QueryContext(ctx, s.db, &dest)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.Customer, len(dest))
+	for i, c := range dest {
+		items[i] = db.Customer(c)
+	}
+	return items, nil
+}
+
+func (s *Store) SearchCustomerHeadline(ctx context.Context, arg db.SearchParams) ([]db.SearchCustomerHeadlineRow, error) {
+	mode, query := string(arg.Mode), arg.Query()
+	var dest []struct {
+		model.Customers
+		Headline string
+	}
+	err := search(SELECT(Customers.AllColumns, headline(Customers.CompanyName, mode, query)).FROM(Customers), Customers.CompanyName, Customers.ID, mode, query, arg.Limit, arg.Offset).
+		QueryContext(ctx, s.db, &dest)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.SearchCustomerHeadlineRow, len(dest))
+	for i, c := range dest {
+		row := db.Customer(c.Customers)
+		items[i] = db.SearchCustomerHeadlineRow{
+			ID:           row.ID,
+			CompanyName:  row.CompanyName,
+			ContactName:  row.ContactName,
+			ContactTitle: row.ContactTitle,
+			Address:      row.Address,
+			City:         row.City,
+			PostalCode:   row.PostalCode,
+			Region:       row.Region,
+			Country:      row.Country,
+			Phone:        row.Phone,
+			Fax:          row.Fax,
+			Headline:     c.Headline,
+		}
+	}
+	return items, nil
+}
+
+func (s *Store) Employees(ctx context.Context, arg db.EmployeesParams) ([]db.EmployeesRow, error) {
+	var dest []model.Employees
+	err := SELECT(Employees.AllColumns).
+		FROM(Employees).
+		ORDER_BY(Employees.ID.ASC()).
+		LIMIT(int64(arg.Limit)).
+		OFFSET(int64(arg.Offset)).
+		QueryContext(ctx, s.db, &dest)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.EmployeesRow, len(dest))
+	for i, e := range dest {
+		items[i] = employeeRow(e)
+	}
+	return items, nil
+}
+
+func (s *Store) EmployeeWithRecipient(ctx context.Context, id int32) ([]db.EmployeeWithRecipientRow, error) {
+	d1 := Employees.AS("d1")
+	recipient := LATERAL(
+		SELECT_JSON_OBJ(employeeJSON(d1)...).
+			FROM(d1).
+			WHERE(d1.ID.EQ(Employees.RecipientID)),
+	).AS("recipient")
+
+	var dest []struct {
+		model.Employees
+		Recipient db.RawJSON
+	}
+	err := SELECT(Employees.AllColumns, jsonColumn(recipient).AS("recipient")).
+		FROM(Employees.LEFT_JOIN(recipient, RawBool("true"))).
+		WHERE(Employees.ID.EQ(Int32(id))).
+		QueryContext(ctx, s.db, &dest)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.EmployeeWithRecipientRow, len(dest))
+	for i, e := range dest {
+		items[i] = employeeRow(e.Employees).WithRecipient(e.Recipient)
+	}
+	return items, nil
+}
+
+func (s *Store) Suppliers(ctx context.Context, arg db.SuppliersParams) ([]db.Supplier, error) {
+	var dest []model.Suppliers
+	err := SELECT(Suppliers.AllColumns).
+		FROM(Suppliers).
+		ORDER_BY(Suppliers.ID.ASC()).
+		LIMIT(int64(arg.Limit)).
+		OFFSET(int64(arg.Offset)).
+		QueryContext(ctx, s.db, &dest)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.Supplier, len(dest))
+	for i, sp := range dest {
+		items[i] = db.Supplier(sp)
+	}
+	return items, nil
+}
+
+func (s *Store) SupplierById(ctx context.Context, id int32) (db.Supplier, error) {
+	var dest model.Suppliers
+	err := SELECT(Suppliers.AllColumns).
+		FROM(Suppliers).
+		WHERE(Suppliers.ID.EQ(Int32(id))).
+		QueryContext(ctx, s.db, &dest)
+	return db.Supplier(dest), notFound(err)
+}
+
+func (s *Store) Products(ctx context.Context, arg db.ProductsParams) ([]db.ProductsRow, error) {
+	var dest []model.Products
+	err := SELECT(Products.AllColumns).
+		FROM(Products).
+		ORDER_BY(Products.ID.ASC()).
+		LIMIT(int64(arg.Limit)).
+		OFFSET(int64(arg.Offset)).
+		QueryContext(ctx, s.db, &dest)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.ProductsRow, len(dest))
+	for i, p := range dest {
+		items[i] = db.ProductRow(db.Product(p))
+	}
+	return items, nil
+}
+
+func (s *Store) ProductWithSupplier(ctx context.Context, id int32) ([]db.ProductWithSupplierRow, error) {
+	d1 := Suppliers.AS("d1")
+	supplier := LATERAL(
+		SELECT_JSON_OBJ(supplierJSON(d1)...).
+			FROM(d1).
+			WHERE(d1.ID.EQ(Products.SupplierID)),
+	).AS("supplier")
+
+	var dest []struct {
+		model.Products
+		Supplier db.RawJSON
+	}
+	err := SELECT(Products.AllColumns, jsonColumn(supplier).AS("supplier")).
+		FROM(Products.LEFT_JOIN(supplier, RawBool("true"))).
+		WHERE(Products.ID.EQ(Int32(id))).
+		QueryContext(ctx, s.db, &dest)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.ProductWithSupplierRow, len(dest))
+	for i, p := range dest {
+		items[i] = db.ProductRow(db.Product(p.Products)).WithSupplier(p.Supplier)
+	}
+	return items, nil
+}
+
+func (s *Store) SearchProduct(ctx context.Context, arg db.SearchParams) ([]db.SearchProductRow, error) {
+	var dest []model.Products
+	err := search(SELECT(Products.AllColumns).FROM(Products), Products.Name, Products.ID, string(arg.Mode), arg.Query(), arg.Limit, arg.Offset).
+		QueryContext(ctx, s.db, &dest)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.SearchProductRow, len(dest))
+	for i, p := range dest {
+		items[i] = db.SearchProductRow(db.ProductRow(db.Product(p)))
+	}
+	return items, nil
+}
+
+func (s *Store) SearchProductHeadline(ctx context.Context, arg db.SearchParams) ([]db.SearchProductHeadlineRow, error) {
+	mode, query := string(arg.Mode), arg.Query()
+	var dest []struct {
+		model.Products
+		Headline string
+	}
+	err := search(SELECT(Products.AllColumns, headline(Products.Name, mode, query)).FROM(Products), Products.Name, Products.ID, mode, query, arg.Limit, arg.Offset).
+		QueryContext(ctx, s.db, &dest)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.SearchProductHeadlineRow, len(dest))
+	for i, p := range dest {
+		row := db.ProductRow(db.Product(p.Products))
+		items[i] = db.SearchProductHeadlineRow{
+			ID:              row.ID,
+			Name:            row.Name,
+			QuantityPerUnit: row.QuantityPerUnit,
+			UnitPrice:       row.UnitPrice,
+			UnitsInStock:    row.UnitsInStock,
+			UnitsOnOrder:    row.UnitsOnOrder,
+			ReorderLevel:    row.ReorderLevel,
+			Discontinued:    row.Discontinued,
+			SupplierId:      row.SupplierId,
+			Headline:        p.Headline,
+		}
+	}
+	return items, nil
+}
+
+// orderTotals is the destination of the aggregate order queries.
+type orderTotals = []struct {
+	model.Orders
+	ProductsCount int32
+	QuantitySum   int32
+	TotalPrice    float32
+}
+
+func (s *Store) OrdersWithDetails(ctx context.Context, arg db.OrdersWithDetailsParams) ([]db.OrdersWithDetailsRow, error) {
+	var dest orderTotals
+	err := selectOrderTotals().
+		ORDER_BY(Orders.ID.ASC()).
+		LIMIT(int64(arg.Limit)).
+		OFFSET(int64(arg.Offset)).
+		QueryContext(ctx, s.db, &dest)
+	if err != nil {
+		return nil, err
+	}
+
+	return orderTotalsRows(dest), nil
+}
+
+func (s *Store) OrderWithDetails(ctx context.Context, id int32) ([]db.OrderWithDetailsRow, error) {
+	var dest orderTotals
+	err := selectOrderTotals().
+		WHERE(Orders.ID.EQ(Int32(id))).
+		ORDER_BY(Orders.ID.ASC()).
+		QueryContext(ctx, s.db, &dest)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := orderTotalsRows(dest)
+	items := make([]db.OrderWithDetailsRow, len(rows))
+	for i, r := range rows {
+		items[i] = db.OrderWithDetailsRow(r)
+	}
+	return items, nil
+}
+
+func (s *Store) OrderWithDetailsAndProducts(ctx context.Context, id int32) ([]db.OrderWithDetailsAndProductsRow, error) {
+	d1 := OrderDetails.AS("d1")
+	d2 := Products.AS("d2")
+	product := LATERAL(
+		SELECT_JSON_OBJ(productJSON(d2)...).
+			FROM(d2).
+			WHERE(d2.ID.EQ(d1.ProductID)),
+	).AS("product")
+	details := LATERAL(
+		SELECT_JSON_ARR(
+			d1.UnitPrice.AS("unitPrice"),
+			d1.Quantity.AS("quantity"),
+			d1.Discount.AS("discount"),
+			d1.OrderID.AS("orderId"),
+			d1.ProductID.AS("productId"),
+			jsonColumn(product).AS("product"),
+		).
+			FROM(d1.LEFT_JOIN(product, RawBool("true"))).
+			WHERE(d1.OrderID.EQ(Orders.ID)),
+	).AS("details")
+
+	var dest []struct {
+		model.Orders
+		Details db.RawJSON
+	}
+	// json_agg over no rows is NULL, where the sqlc query returns [].
+	err := SELECT(Orders.AllColumns, COALESCE(jsonColumn(details), RawString("'[]'::json")).AS("details")).
+		FROM(Orders.LEFT_JOIN(details, RawBool("true"))).
+		WHERE(Orders.ID.EQ(Int32(id))).
+		QueryContext(ctx, s.db, &dest)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]db.OrderWithDetailsAndProductsRow, len(dest))
+	for i, o := range dest {
+		items[i] = orderRow(o.Orders).WithDetails(o.Details)
+	}
+	return items, nil
+}
+
+func selectOrderTotals() SelectStatement {
+	return SELECT(
+		Orders.ID,
+		Orders.ShippedDate,
+		Orders.ShipName,
+		Orders.ShipCity,
+		Orders.ShipCountry,
+		CAST(COUNT(OrderDetails.ProductID)).AS_INTEGER().AS("productsCount"),
+		CAST(SUM(OrderDetails.Quantity)).AS_INTEGER().AS("quantitySum"),
+		CAST(SUMf(FloatExp(OrderDetails.Quantity).MUL(OrderDetails.UnitPrice))).AS_REAL().AS("totalPrice"),
+	).
+		FROM(Orders.LEFT_JOIN(OrderDetails, OrderDetails.OrderID.EQ(Orders.ID))).
+		GROUP_BY(Orders.ID)
+}
+
+func orderTotalsRows(dest orderTotals) []db.OrdersWithDetailsRow {
+	items := make([]db.OrdersWithDetailsRow, len(dest))
+	for i, o := range dest {
+		items[i] = db.OrdersWithDetailsRow{
+			ID:            o.ID,
+			ShippedDate:   db.DateOf(o.ShippedDate),
+			ShipName:      o.ShipName,
+			ShipCity:      o.ShipCity,
+			ShipCountry:   o.ShipCountry,
+			ProductsCount: o.ProductsCount,
+			QuantitySum:   o.QuantitySum,
+			TotalPrice:    o.TotalPrice,
+		}
+	}
+	return items
+}
+
+// The JSON projections name every key the way the sqlc queries do. Dates are
+// formatted like the sqlc queries format them, since SELECT_JSON would leave
+// out the milliseconds Drizzle writes.
+
+func employeeJSON(e *EmployeesTable) []Projection {
+	return []Projection{
+		e.ID.AS("id"),
+		e.LastName.AS("lastName"),
+		e.FirstName.AS("firstName"),
+		e.Title.AS("title"),
+		e.TitleOfCourtesy.AS("titleOfCourtesy"),
+		jsDate(e.BirthDate).AS("birthDate"),
+		jsDate(e.HireDate).AS("hireDate"),
+		e.Address.AS("address"),
+		e.City.AS("city"),
+		e.PostalCode.AS("postalCode"),
+		e.Country.AS("country"),
+		e.HomePhone.AS("homePhone"),
+		e.Extension.AS("extension"),
+		e.Notes.AS("notes"),
+		e.RecipientID.AS("recipientId"),
+	}
+}
+
+func supplierJSON(s *SuppliersTable) []Projection {
+	return []Projection{
+		s.ID.AS("id"),
+		s.CompanyName.AS("companyName"),
+		s.ContactName.AS("contactName"),
+		s.ContactTitle.AS("contactTitle"),
+		s.Address.AS("address"),
+		s.City.AS("city"),
+		s.Region.AS("region"),
+		s.PostalCode.AS("postalCode"),
+		s.Country.AS("country"),
+		s.Phone.AS("phone"),
+	}
+}
+
+func productJSON(p *ProductsTable) []Projection {
+	return []Projection{
+		p.ID.AS("id"),
+		p.Name.AS("name"),
+		p.QtPerUnit.AS("quantityPerUnit"),
+		p.UnitPrice.AS("unitPrice"),
+		p.UnitsInStock.AS("unitsInStock"),
+		p.UnitsOnOrder.AS("unitsOnOrder"),
+		p.ReorderLevel.AS("reorderLevel"),
+		p.Discontinued.AS("discontinued"),
+		p.SupplierID.AS("supplierId"),
+	}
+}
+
+// jsonColumn is the single column SELECT_JSON_OBJ and SELECT_JSON_ARR project.
+func jsonColumn(lateral SelectTable) StringExpression {
+	return StringColumn("json").From(lateral)
+}
+
+var english = Raw("'english'")
+
+// jsDate formats a date column the way the sqlc lateral queries do, as
+// Date.prototype.toJSON would.
+func jsDate(column ColumnDate) Expression {
+	return Func("to_char", column, Raw(`'YYYY-MM-DD"T"HH24:MI:SS.MS"Z"'`))
+}
+
+// search filters, ranks and paginates a full-text query over column, the same
+// way the sqlc search queries do.
+func search(stmt SelectStatement, column ColumnString, id ColumnInteger, mode, query string, limit, offset int32) SelectStatement {
+	vector := Func("to_tsvector", english, column)
+
+	return stmt.
+		WHERE(BoolExp(CustomExpression(vector, Token("@@"), tsquery(mode, query)))).
+		ORDER_BY(FloatExp(Func("ts_rank", vector, tsquery(mode, query))).DESC(), id.ASC()).
+		LIMIT(int64(limit)).
+		OFFSET(int64(offset))
+}
+
+func headline(column ColumnString, mode, query string) Projection {
+	return CAST(Func("ts_headline", english, column, tsquery(mode, query))).AS_TEXT().AS("headline")
+}
+
+func tsquery(mode, query string) Expression {
+	return Func(db.TSQueryFunc(mode), english, String(query))
+}
+
+func employeeRow(e model.Employees) db.EmployeesRow {
+	return db.EmployeesRow{
+		ID:              e.ID,
+		LastName:        e.LastName,
+		FirstName:       e.FirstName,
+		Title:           e.Title,
+		TitleOfCourtesy: e.TitleOfCourtesy,
+		BirthDate:       db.DateOf(&e.BirthDate),
+		HireDate:        db.DateOf(&e.HireDate),
+		Address:         e.Address,
+		City:            e.City,
+		PostalCode:      e.PostalCode,
+		Country:         e.Country,
+		HomePhone:       e.HomePhone,
+		Extension:       e.Extension,
+		Notes:           e.Notes,
+		RecipientId:     e.RecipientID,
+	}
+}
+
+func orderRow(o model.Orders) db.Order {
+	return db.Order{
+		ID:             o.ID,
+		OrderDate:      db.DateOf(&o.OrderDate),
+		RequiredDate:   db.DateOf(&o.RequiredDate),
+		ShippedDate:    db.DateOf(o.ShippedDate),
+		ShipVia:        o.ShipVia,
+		Freight:        o.Freight,
+		ShipName:       o.ShipName,
+		ShipCity:       o.ShipCity,
+		ShipRegion:     o.ShipRegion,
+		ShipPostalCode: o.ShipPostalCode,
+		ShipCountry:    o.ShipCountry,
+		CustomerID:     o.CustomerID,
+		EmployeeID:     o.EmployeeID,
+	}
+}
+
+func notFound(err error) error {
+	if errors.Is(err, qrm.ErrNoRows) {
+		return sql.ErrNoRows
+	}
+	return err
+}
+
+var (
+	_ db.Store       = (*Store)(nil)
+	_ db.Highlighter = (*Store)(nil)
+	_ db.Pinger      = (*Store)(nil)
+)
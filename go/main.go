@@ -2,62 +2,83 @@ package main
 
 import (
 	"context"
+	"flag"
+	"fmt"
+	"log"
 	"math"
 	"os"
 	"os/signal"
 	"perf-drizzle/go/db"
 	"sync"
 	"syscall"
-	"unsafe"
 
-	"github.com/bytedance/sonic"
 	"github.com/gofiber/fiber/v3"
 	"github.com/shirou/gopsutil/v4/cpu"
-	"github.com/valyala/fasthttp"
 )
 
-func b2s(b []byte) string {
-	return *(*string)(unsafe.Pointer(&b))
-}
-
-func getInt32(c fiber.Ctx, key string) int32 {
-	val := c.Request().URI().QueryArgs().Peek(key)
-	if len(val) == 0 {
-		return 0
-	}
-
-	n, err := fasthttp.ParseUint(val)
-	if err != nil {
-		return 0
-	}
-
-	return int32(n)
-}
-
 type CPUData struct {
 	Usage float64
 	Total float64
 }
 
+// Meta describes what a run is measuring, so results can be labelled from
+// the server itself.
+type Meta struct {
+	Backend   string          `json:"backend"`
+	Relations db.RelationMode `json:"relations"`
+	ExecMode  db.ExecMode     `json:"execMode,omitempty"`
+	WarmUp    string          `json:"warmUp,omitempty"`
+}
+
 func main() {
+	cfg, err := loadConfig(flag.CommandLine, os.Args[1:])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
 	databaseUrl := os.Getenv("DATABASE_URL")
-	if databaseUrl == "" {
+	if databaseUrl == "" && cfg.Backend != "sqlite" {
 		panic("DATABASE_URL is not set")
 	}
 
-	pg, err := db.NewDatabase(databaseUrl)
+	store, err := newStore(&cfg, databaseUrl)
 	if err != nil {
 		panic(err)
 	}
 
+	highlighter, _ := store.(db.Highlighter)
+	encoder, _ := store.(db.Encoder)
+
+	meta := Meta{Backend: cfg.Backend, Relations: cfg.Relations}
+	if client, ok := store.(*db.Client); ok {
+		meta.ExecMode = client.ExecMode()
+
+		if d := client.WarmUp(); d > 0 {
+			meta.WarmUp = d.String()
+			log.Printf("warmed up %d connections in %s", cfg.Pool.MinConns, d)
+		}
+	}
+
+	engine := jsonEngines[cfg.JSONEngine]
 	app := fiber.New(fiber.Config{
-		JSONEncoder: sonic.ConfigDefault.Marshal,
-		JSONDecoder: sonic.ConfigDefault.Unmarshal,
+		Concurrency:     cfg.Concurrency,
+		ReadBufferSize:  cfg.ReadBufferSize,
+		WriteBufferSize: cfg.WriteBufferSize,
+		JSONEncoder:     engine.marshal,
+		JSONDecoder:     engine.unmarshal,
+		ErrorHandler:    errorHandler,
 	})
 
+	requests := &requestCounter{}
+	app.Use(requests.handler)
+	if cfg.RequestTimeout > 0 {
+		app.Use(deadline(cfg.RequestTimeout))
+	}
+
 	var (
 		temp []CPUData
 		mu   sync.Mutex
@@ -107,11 +128,39 @@ func main() {
 		return c.JSON(result)
 	})
 
+	app.Get("/meta", func(c fiber.Ctx) error {
+		return c.JSON(meta)
+	})
+
+	ready := &readiness{store: store, warmUp: meta.WarmUp}
+	if servesFromPostgres(cfg.Backend) {
+		ready.databaseUrl = databaseUrl
+	}
+	ready.routes(app)
+
+	effective := effectiveConfig(flag.CommandLine, meta.ExecMode)
+	app.Get("/config", func(c fiber.Ctx) error {
+		return c.JSON(effective)
+	})
+
 	app.Get("/customers", func(c fiber.Ctx) error {
-		rows, err := pg.Customers(c.Context(), db.CustomersParams{
-			Limit:  getInt32(c, "limit"),
-			Offset: getInt32(c, "offset"),
-		})
+		limit, offset, err := pagination(c)
+		if err != nil {
+			return err
+		}
+
+		arg := db.CustomersParams{
+			Limit:  limit,
+			Offset: offset,
+		}
+
+		if encoder != nil {
+			return sendEncoded(c, func(buf []byte) ([]byte, error) {
+				return encoder.AppendCustomers(c.Context(), buf, arg)
+			})
+		}
+
+		rows, err := store.Customers(c.Context(), arg)
 		if err != nil {
 			return err
 		}
@@ -120,7 +169,18 @@ func main() {
 	})
 
 	app.Get("/customer-by-id", func(c fiber.Ctx) error {
-		row, err := pg.CustomerById(c.Context(), getInt32(c, "id"))
+		id, err := idParam.get(c)
+		if err != nil {
+			return err
+		}
+
+		if encoder != nil {
+			return sendEncoded(c, func(buf []byte) ([]byte, error) {
+				return encoder.AppendCustomerById(c.Context(), buf, id)
+			})
+		}
+
+		row, err := store.CustomerById(c.Context(), id)
 		if err != nil {
 			return err
 		}
@@ -129,9 +189,49 @@ func main() {
 	})
 
 	app.Get("/search-customer", func(c fiber.Ctx) error {
-		term := b2s(c.Request().URI().QueryArgs().Peek("term"))
+		s, err := search(c)
+		if err != nil {
+			return err
+		}
 
-		rows, err := pg.SearchCustomer(c.Context(), term+":*")
+		limit, offset, err := pagination(c)
+		if err != nil {
+			return err
+		}
+
+		highlight, err := highlightParam.get(c)
+		if err != nil {
+			return err
+		}
+
+		arg := db.SearchParams{Search: s, Limit: limit, Offset: offset}
+
+		if highlight {
+			if highlighter == nil {
+				return paramError(highlightParam.name, "is not supported by the "+cfg.Backend+" backend")
+			}
+
+			if encoder != nil {
+				return sendEncoded(c, func(buf []byte) ([]byte, error) {
+					return encoder.AppendSearchCustomerHeadline(c.Context(), buf, arg)
+				})
+			}
+
+			rows, err := highlighter.SearchCustomerHeadline(c.Context(), arg)
+			if err != nil {
+				return err
+			}
+
+			return c.JSON(rows)
+		}
+
+		if encoder != nil {
+			return sendEncoded(c, func(buf []byte) ([]byte, error) {
+				return encoder.AppendSearchCustomer(c.Context(), buf, arg)
+			})
+		}
+
+		rows, err := store.SearchCustomer(c.Context(), arg)
 		if err != nil {
 			return err
 		}
@@ -140,10 +240,23 @@ func main() {
 	})
 
 	app.Get("/employees", func(c fiber.Ctx) error {
-		rows, err := pg.Employees(c.Context(), db.EmployeesParams{
-			Limit:  getInt32(c, "limit"),
-			Offset: getInt32(c, "offset"),
-		})
+		limit, offset, err := pagination(c)
+		if err != nil {
+			return err
+		}
+
+		arg := db.EmployeesParams{
+			Limit:  limit,
+			Offset: offset,
+		}
+
+		if encoder != nil {
+			return sendEncoded(c, func(buf []byte) ([]byte, error) {
+				return encoder.AppendEmployees(c.Context(), buf, arg)
+			})
+		}
+
+		rows, err := store.Employees(c.Context(), arg)
 		if err != nil {
 			return err
 		}
@@ -152,19 +265,52 @@ func main() {
 	})
 
 	app.Get("/employee-with-recipient", func(c fiber.Ctx) error {
-		row, err := pg.EmployeeWithRecipient(c.Context(), getInt32(c, "id"))
+		id, err := idParam.get(c)
 		if err != nil {
 			return err
 		}
 
-		return c.JSON(row)
+		if encoder != nil && cfg.Relations == db.RelationsRaw {
+			return sendEncoded(c, func(buf []byte) ([]byte, error) {
+				return encoder.AppendEmployeeWithRecipient(c.Context(), buf, id)
+			})
+		}
+
+		rows, err := store.EmployeeWithRecipient(c.Context(), id)
+		if err != nil {
+			return err
+		}
+
+		if cfg.Relations == db.RelationsTyped {
+			items, err := db.DecodeEmployeeWithRecipient(rows)
+			if err != nil {
+				return err
+			}
+
+			return c.JSON(items)
+		}
+
+		return c.JSON(rows)
 	})
 
 	app.Get("/suppliers", func(c fiber.Ctx) error {
-		rows, err := pg.Suppliers(c.Context(), db.SuppliersParams{
-			Limit:  getInt32(c, "limit"),
-			Offset: getInt32(c, "offset"),
-		})
+		limit, offset, err := pagination(c)
+		if err != nil {
+			return err
+		}
+
+		arg := db.SuppliersParams{
+			Limit:  limit,
+			Offset: offset,
+		}
+
+		if encoder != nil {
+			return sendEncoded(c, func(buf []byte) ([]byte, error) {
+				return encoder.AppendSuppliers(c.Context(), buf, arg)
+			})
+		}
+
+		rows, err := store.Suppliers(c.Context(), arg)
 		if err != nil {
 			return err
 		}
@@ -173,7 +319,18 @@ func main() {
 	})
 
 	app.Get("/supplier-by-id", func(c fiber.Ctx) error {
-		row, err := pg.SupplierById(c.Context(), getInt32(c, "id"))
+		id, err := idParam.get(c)
+		if err != nil {
+			return err
+		}
+
+		if encoder != nil {
+			return sendEncoded(c, func(buf []byte) ([]byte, error) {
+				return encoder.AppendSupplierById(c.Context(), buf, id)
+			})
+		}
+
+		row, err := store.SupplierById(c.Context(), id)
 		if err != nil {
 			return err
 		}
@@ -182,10 +339,23 @@ func main() {
 	})
 
 	app.Get("/products", func(c fiber.Ctx) error {
-		rows, err := pg.Products(c.Context(), db.ProductsParams{
-			Limit:  getInt32(c, "limit"),
-			Offset: getInt32(c, "offset"),
-		})
+		limit, offset, err := pagination(c)
+		if err != nil {
+			return err
+		}
+
+		arg := db.ProductsParams{
+			Limit:  limit,
+			Offset: offset,
+		}
+
+		if encoder != nil {
+			return sendEncoded(c, func(buf []byte) ([]byte, error) {
+				return encoder.AppendProducts(c.Context(), buf, arg)
+			})
+		}
+
+		rows, err := store.Products(c.Context(), arg)
 		if err != nil {
 			return err
 		}
@@ -194,18 +364,78 @@ func main() {
 	})
 
 	app.Get("/product-with-supplier", func(c fiber.Ctx) error {
-		row, err := pg.ProductWithSupplier(c.Context(), getInt32(c, "id"))
+		id, err := idParam.get(c)
 		if err != nil {
 			return err
 		}
 
-		return c.JSON(row)
+		if encoder != nil && cfg.Relations == db.RelationsRaw {
+			return sendEncoded(c, func(buf []byte) ([]byte, error) {
+				return encoder.AppendProductWithSupplier(c.Context(), buf, id)
+			})
+		}
+
+		rows, err := store.ProductWithSupplier(c.Context(), id)
+		if err != nil {
+			return err
+		}
+
+		if cfg.Relations == db.RelationsTyped {
+			items, err := db.DecodeProductWithSupplier(rows)
+			if err != nil {
+				return err
+			}
+
+			return c.JSON(items)
+		}
+
+		return c.JSON(rows)
 	})
 
 	app.Get("/search-product", func(c fiber.Ctx) error {
-		term := b2s(c.Request().URI().QueryArgs().Peek("term"))
+		s, err := search(c)
+		if err != nil {
+			return err
+		}
 
-		rows, err := pg.SearchProduct(c.Context(), term+":*")
+		limit, offset, err := pagination(c)
+		if err != nil {
+			return err
+		}
+
+		highlight, err := highlightParam.get(c)
+		if err != nil {
+			return err
+		}
+
+		arg := db.SearchParams{Search: s, Limit: limit, Offset: offset}
+
+		if highlight {
+			if highlighter == nil {
+				return paramError(highlightParam.name, "is not supported by the "+cfg.Backend+" backend")
+			}
+
+			if encoder != nil {
+				return sendEncoded(c, func(buf []byte) ([]byte, error) {
+					return encoder.AppendSearchProductHeadline(c.Context(), buf, arg)
+				})
+			}
+
+			rows, err := highlighter.SearchProductHeadline(c.Context(), arg)
+			if err != nil {
+				return err
+			}
+
+			return c.JSON(rows)
+		}
+
+		if encoder != nil {
+			return sendEncoded(c, func(buf []byte) ([]byte, error) {
+				return encoder.AppendSearchProduct(c.Context(), buf, arg)
+			})
+		}
+
+		rows, err := store.SearchProduct(c.Context(), arg)
 		if err != nil {
 			return err
 		}
@@ -214,10 +444,23 @@ func main() {
 	})
 
 	app.Get("/orders-with-details", func(c fiber.Ctx) error {
-		rows, err := pg.OrdersWithDetails(c.Context(), db.OrdersWithDetailsParams{
-			Limit:  getInt32(c, "limit"),
-			Offset: getInt32(c, "offset"),
-		})
+		limit, offset, err := pagination(c)
+		if err != nil {
+			return err
+		}
+
+		arg := db.OrdersWithDetailsParams{
+			Limit:  limit,
+			Offset: offset,
+		}
+
+		if encoder != nil {
+			return sendEncoded(c, func(buf []byte) ([]byte, error) {
+				return encoder.AppendOrdersWithDetails(c.Context(), buf, arg)
+			})
+		}
+
+		rows, err := store.OrdersWithDetails(c.Context(), arg)
 		if err != nil {
 			return err
 		}
@@ -226,31 +469,79 @@ func main() {
 	})
 
 	app.Get("/order-with-details", func(c fiber.Ctx) error {
-		row, err := pg.OrderWithDetails(c.Context(), getInt32(c, "id"))
+		id, err := idParam.get(c)
 		if err != nil {
 			return err
 		}
 
-		return c.JSON(row)
+		if encoder != nil {
+			return sendEncoded(c, func(buf []byte) ([]byte, error) {
+				return encoder.AppendOrderWithDetails(c.Context(), buf, id)
+			})
+		}
+
+		rows, err := store.OrderWithDetails(c.Context(), id)
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(rows)
 	})
 
 	app.Get("/order-with-details-and-products", func(c fiber.Ctx) error {
-		row, err := pg.OrderWithDetailsAndProducts(c.Context(), getInt32(c, "id"))
+		id, err := idParam.get(c)
 		if err != nil {
 			return err
 		}
 
-		return c.JSON(row)
+		if encoder != nil && cfg.Relations == db.RelationsRaw {
+			return sendEncoded(c, func(buf []byte) ([]byte, error) {
+				return encoder.AppendOrderWithDetailsAndProducts(c.Context(), buf, id)
+			})
+		}
+
+		rows, err := store.OrderWithDetailsAndProducts(c.Context(), id)
+		if err != nil {
+			return err
+		}
+
+		if cfg.Relations == db.RelationsTyped {
+			items, err := db.DecodeOrderWithDetailsAndProducts(rows)
+			if err != nil {
+				return err
+			}
+
+			return c.JSON(items)
+		}
+
+		return c.JSON(rows)
 	})
 
 	go func() {
-		if err := app.Listen(":3002"); err != nil {
+		if err := app.Listen(fmt.Sprintf(":%d", cfg.Port)); err != nil {
 			panic(err)
 		}
 	}()
 
 	<-ctx.Done()
 
-	pg.Close()
-	app.Shutdown()
+	shutdown(app, store, requests, ready, cfg.DrainDelay, cfg.ShutdownTimeout)
+}
+
+// sendEncoded has a db.Encoder append the JSON body straight into the pooled
+// buffer fasthttp already holds for the response, so the rows are never
+// copied out of the wire buffers or boxed into structs.
+func sendEncoded(c fiber.Ctx, encode func(buf []byte) ([]byte, error)) error {
+	resp := c.Response()
+
+	buf, err := encode(resp.SwapBody(nil)[:0])
+	if err != nil {
+		resp.SwapBody(buf[:0])
+		return err
+	}
+
+	resp.SwapBody(buf)
+	resp.Header.SetContentType(fiber.MIMEApplicationJSONCharsetUTF8)
+
+	return nil
 }
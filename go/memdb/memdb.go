@@ -0,0 +1,382 @@
+// Package memdb implements db.Store from memory. New reads the seeded tables
+// once and closes the connection, then every route is served from slices
+// ordered by id, id maps, prefix-search indexes and precomputed order totals
+// and relation JSON, so a benchmark against it measures Fiber and sonic alone.
+//
+// The rows it returns are shared between requests and must not be modified.
+package memdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"perf-drizzle/go/db"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/jackc/pgx/v5"
+)
+
+type Store struct {
+	customers      []db.Customer
+	customerIDs    map[int32]int
+	customerSearch textIndex
+
+	employees             []db.EmployeesRow
+	employeeWithRecipient map[int32][]db.EmployeeWithRecipientRow
+
+	suppliers   []db.Supplier
+	supplierIDs map[int32]int
+
+	products            []db.ProductsRow
+	productWithSupplier map[int32][]db.ProductWithSupplierRow
+	productSearch       textIndex
+
+	orderTotals                 []db.OrdersWithDetailsRow
+	orderTotalIDs               map[int32]int
+	orderWithDetailsAndProducts map[int32][]db.OrderWithDetailsAndProductsRow
+}
+
+// The tables as the sqlc models lay them out, in id order.
+const (
+	loadCustomers    = `select id, company_name, contact_name, contact_title, address, city, postal_code, region, country, phone, fax from customers order by id`
+	loadEmployees    = `select id, last_name, first_name, title, title_of_courtesy, birth_date, hire_date, address, city, postal_code, country, home_phone, extension, notes, recipient_id from employees order by id`
+	loadSuppliers    = `select id, company_name, contact_name, contact_title, address, city, region, postal_code, country, phone from suppliers order by id`
+	loadProducts     = `select id, name, qt_per_unit, unit_price, units_in_stock, units_on_order, reorder_level, discontinued, supplier_id from products order by id`
+	loadOrders       = `select id, order_date, required_date, shipped_date, ship_via, freight, ship_name, ship_city, ship_region, ship_postal_code, ship_country, customer_id, employee_id from orders order by id`
+	loadOrderDetails = `select unit_price, quantity, discount, order_id, product_id from order_details order by order_id`
+)
+
+func New(databaseUrl string) (*Store, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	conn, err := pgx.Connect(ctx, databaseUrl)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close(context.Background())
+
+	customers, err := load[db.Customer](ctx, conn, loadCustomers)
+	if err != nil {
+		return nil, err
+	}
+	employees, err := load[db.Employee](ctx, conn, loadEmployees)
+	if err != nil {
+		return nil, err
+	}
+	suppliers, err := load[db.Supplier](ctx, conn, loadSuppliers)
+	if err != nil {
+		return nil, err
+	}
+	products, err := load[db.Product](ctx, conn, loadProducts)
+	if err != nil {
+		return nil, err
+	}
+	orders, err := load[db.Order](ctx, conn, loadOrders)
+	if err != nil {
+		return nil, err
+	}
+	details, err := load[db.OrderDetail](ctx, conn, loadOrderDetails)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Store{
+		customers: customers,
+		customerSearch: newTextIndex(len(customers), func(i int) string {
+			return customers[i].CompanyName
+		}),
+		suppliers: suppliers,
+	}
+	s.customerIDs = indexIDs(customers, func(c db.Customer) int32 { return c.ID })
+	s.supplierIDs = indexIDs(suppliers, func(s db.Supplier) int32 { return s.ID })
+
+	if err := s.loadEmployees(employees); err != nil {
+		return nil, err
+	}
+	if err := s.loadProducts(products); err != nil {
+		return nil, err
+	}
+	if err := s.loadOrders(orders, details); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func load[T any](ctx context.Context, conn *pgx.Conn, query string) ([]T, error) {
+	rows, err := conn.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := pgx.CollectRows(rows, pgx.RowToStructByPos[T])
+	if err != nil {
+		return nil, fmt.Errorf("memdb: %s: %w", query, err)
+	}
+
+	return items, nil
+}
+
+func indexIDs[T any](items []T, id func(T) int32) map[int32]int {
+	ids := make(map[int32]int, len(items))
+	for i, item := range items {
+		ids[id(item)] = i
+	}
+	return ids
+}
+
+func (s *Store) loadEmployees(employees []db.Employee) error {
+	s.employees = make([]db.EmployeesRow, len(employees))
+	for i, e := range employees {
+		s.employees[i] = db.EmployeeRow(e)
+	}
+
+	ids := indexIDs(s.employees, func(e db.EmployeesRow) int32 { return e.ID })
+
+	s.employeeWithRecipient = make(map[int32][]db.EmployeeWithRecipientRow, len(s.employees))
+	for _, e := range s.employees {
+		var recipient db.RawJSON
+		if e.RecipientId != nil {
+			if i, ok := ids[*e.RecipientId]; ok {
+				var err error
+				if recipient, err = sonic.Marshal(s.employees[i]); err != nil {
+					return err
+				}
+			}
+		}
+
+		s.employeeWithRecipient[e.ID] = []db.EmployeeWithRecipientRow{e.WithRecipient(recipient)}
+	}
+
+	return nil
+}
+
+func (s *Store) loadProducts(products []db.Product) error {
+	s.products = make([]db.ProductsRow, len(products))
+	for i, p := range products {
+		s.products[i] = db.ProductRow(p)
+	}
+
+	s.productSearch = newTextIndex(len(s.products), func(i int) string {
+		return s.products[i].Name
+	})
+
+	s.productWithSupplier = make(map[int32][]db.ProductWithSupplierRow, len(s.products))
+	for _, p := range s.products {
+		var supplier db.RawJSON
+		if i, ok := s.supplierIDs[p.SupplierId]; ok {
+			var err error
+			if supplier, err = sonic.Marshal(s.suppliers[i]); err != nil {
+				return err
+			}
+		}
+
+		s.productWithSupplier[p.ID] = []db.ProductWithSupplierRow{p.WithSupplier(supplier)}
+	}
+
+	return nil
+}
+
+// loadOrders precomputes the aggregates of the order totals queries and the
+// details json of every order, from details sorted by order id.
+func (s *Store) loadOrders(orders []db.Order, details []db.OrderDetail) error {
+	productIDs := indexIDs(s.products, func(p db.ProductsRow) int32 { return p.ID })
+
+	s.orderTotals = make([]db.OrdersWithDetailsRow, len(orders))
+	s.orderTotalIDs = make(map[int32]int, len(orders))
+	s.orderWithDetailsAndProducts = make(map[int32][]db.OrderWithDetailsAndProductsRow, len(orders))
+
+	for i, o := range orders {
+		for len(details) > 0 && details[0].OrderID < o.ID {
+			details = details[1:]
+		}
+
+		n := 0
+		for n < len(details) && details[n].OrderID == o.ID {
+			n++
+		}
+
+		withProducts := make([]db.OrderDetailWithProduct, n)
+		totals := db.OrdersWithDetailsRow{
+			ID:            o.ID,
+			ShippedDate:   o.ShippedDate,
+			ShipName:      o.ShipName,
+			ShipCity:      o.ShipCity,
+			ShipCountry:   o.ShipCountry,
+			ProductsCount: int32(n),
+		}
+
+		var totalPrice float64
+		for j, d := range details[:n] {
+			totals.QuantitySum += d.Quantity
+			totalPrice += float64(d.Quantity) * d.UnitPrice
+
+			withProducts[j].OrderDetail = d
+			if k, ok := productIDs[d.ProductID]; ok {
+				withProducts[j].Product = &s.products[k]
+			}
+		}
+		totals.TotalPrice = float32(totalPrice)
+
+		raw, err := sonic.Marshal(withProducts)
+		if err != nil {
+			return err
+		}
+
+		s.orderTotals[i] = totals
+		s.orderTotalIDs[o.ID] = i
+		s.orderWithDetailsAndProducts[o.ID] = []db.OrderWithDetailsAndProductsRow{o.WithDetails(raw)}
+
+		details = details[n:]
+	}
+
+	return nil
+}
+
+func (s *Store) Close() {}
+
+// page cuts one page out of rows, like limit and offset.
+func page[T any](rows []T, limit, offset int32) []T {
+	start := min(int(offset), len(rows))
+	end := min(start+int(limit), len(rows))
+	return rows[start:end:end]
+}
+
+// byID returns the row of an id index, or sql.ErrNoRows like a :one query.
+func byID[T any](rows []T, ids map[int32]int, id int32) (T, error) {
+	i, ok := ids[id]
+	if !ok {
+		var zero T
+		return zero, sql.ErrNoRows
+	}
+	return rows[i], nil
+}
+
+// related returns the precomputed rows of a :many query by id, which are
+// empty rather than nil for a missing id so they encode as [].
+func related[T any](rows map[int32][]T, id int32) []T {
+	if r, ok := rows[id]; ok {
+		return r
+	}
+	return []T{}
+}
+
+// searchPage runs q on x and converts one page of the matches.
+func searchPage[T any](x textIndex, q query, limit, offset int32, row func(doc int) T) []T {
+	docs := page(x.search(q), limit, offset)
+
+	items := make([]T, len(docs))
+	for i, doc := range docs {
+		items[i] = row(doc)
+	}
+	return items
+}
+
+func (s *Store) Customers(ctx context.Context, arg db.CustomersParams) ([]db.Customer, error) {
+	return page(s.customers, arg.Limit, arg.Offset), nil
+}
+
+func (s *Store) CustomerById(ctx context.Context, id int32) (db.Customer, error) {
+	return byID(s.customers, s.customerIDs, id)
+}
+
+func (s *Store) SearchCustomer(ctx context.Context, arg db.SearchParams) ([]db.Customer, error) {
+	q := newQuery(arg.Search)
+	return searchPage(s.customerSearch, q, arg.Limit, arg.Offset, func(doc int) db.Customer {
+		return s.customers[doc]
+	}), nil
+}
+
+func (s *Store) SearchCustomerHeadline(ctx context.Context, arg db.SearchParams) ([]db.SearchCustomerHeadlineRow, error) {
+	q := newQuery(arg.Search)
+	return searchPage(s.customerSearch, q, arg.Limit, arg.Offset, func(doc int) db.SearchCustomerHeadlineRow {
+		c := s.customers[doc]
+		return db.SearchCustomerHeadlineRow{
+			ID:           c.ID,
+			CompanyName:  c.CompanyName,
+			ContactName:  c.ContactName,
+			ContactTitle: c.ContactTitle,
+			Address:      c.Address,
+			City:         c.City,
+			PostalCode:   c.PostalCode,
+			Region:       c.Region,
+			Country:      c.Country,
+			Phone:        c.Phone,
+			Fax:          c.Fax,
+			Headline:     headline(c.CompanyName, q),
+		}
+	}), nil
+}
+
+func (s *Store) Employees(ctx context.Context, arg db.EmployeesParams) ([]db.EmployeesRow, error) {
+	return page(s.employees, arg.Limit, arg.Offset), nil
+}
+
+func (s *Store) EmployeeWithRecipient(ctx context.Context, id int32) ([]db.EmployeeWithRecipientRow, error) {
+	return related(s.employeeWithRecipient, id), nil
+}
+
+func (s *Store) Suppliers(ctx context.Context, arg db.SuppliersParams) ([]db.Supplier, error) {
+	return page(s.suppliers, arg.Limit, arg.Offset), nil
+}
+
+func (s *Store) SupplierById(ctx context.Context, id int32) (db.Supplier, error) {
+	return byID(s.suppliers, s.supplierIDs, id)
+}
+
+func (s *Store) Products(ctx context.Context, arg db.ProductsParams) ([]db.ProductsRow, error) {
+	return page(s.products, arg.Limit, arg.Offset), nil
+}
+
+func (s *Store) ProductWithSupplier(ctx context.Context, id int32) ([]db.ProductWithSupplierRow, error) {
+	return related(s.productWithSupplier, id), nil
+}
+
+func (s *Store) SearchProduct(ctx context.Context, arg db.SearchParams) ([]db.SearchProductRow, error) {
+	q := newQuery(arg.Search)
+	return searchPage(s.productSearch, q, arg.Limit, arg.Offset, func(doc int) db.SearchProductRow {
+		return db.SearchProductRow(s.products[doc])
+	}), nil
+}
+
+func (s *Store) SearchProductHeadline(ctx context.Context, arg db.SearchParams) ([]db.SearchProductHeadlineRow, error) {
+	q := newQuery(arg.Search)
+	return searchPage(s.productSearch, q, arg.Limit, arg.Offset, func(doc int) db.SearchProductHeadlineRow {
+		p := s.products[doc]
+		return db.SearchProductHeadlineRow{
+			ID:              p.ID,
+			Name:            p.Name,
+			QuantityPerUnit: p.QuantityPerUnit,
+			UnitPrice:       p.UnitPrice,
+			UnitsInStock:    p.UnitsInStock,
+			UnitsOnOrder:    p.UnitsOnOrder,
+			ReorderLevel:    p.ReorderLevel,
+			Discontinued:    p.Discontinued,
+			SupplierId:      p.SupplierId,
+			Headline:        headline(p.Name, q),
+		}
+	}), nil
+}
+
+func (s *Store) OrdersWithDetails(ctx context.Context, arg db.OrdersWithDetailsParams) ([]db.OrdersWithDetailsRow, error) {
+	return page(s.orderTotals, arg.Limit, arg.Offset), nil
+}
+
+func (s *Store) OrderWithDetails(ctx context.Context, id int32) ([]db.OrderWithDetailsRow, error) {
+	i, ok := s.orderTotalIDs[id]
+	if !ok {
+		return []db.OrderWithDetailsRow{}, nil
+	}
+	return []db.OrderWithDetailsRow{db.OrderWithDetailsRow(s.orderTotals[i])}, nil
+}
+
+func (s *Store) OrderWithDetailsAndProducts(ctx context.Context, id int32) ([]db.OrderWithDetailsAndProductsRow, error) {
+	return related(s.orderWithDetailsAndProducts, id), nil
+}
+
+var (
+	_ db.Store       = (*Store)(nil)
+	_ db.Highlighter = (*Store)(nil)
+)
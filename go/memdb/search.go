@@ -0,0 +1,153 @@
+package memdb
+
+import (
+	"perf-drizzle/go/db"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// query is a search term reduced to what the in-memory index can evaluate.
+// It approximates the Postgres tsquery of the same mode: words match from
+// the start of a document word in prefix mode and whole in the others, with
+// no stemming and no stop words, and only prefix mode can join them with OR.
+type query struct {
+	words  []string
+	prefix bool
+	any    bool
+}
+
+func newQuery(s db.Search) query {
+	words := slices.Clone(s.Words)
+	slices.Sort(words)
+
+	prefix := s.Mode == db.SearchPrefix
+	return query{
+		words:  slices.Compact(words),
+		prefix: prefix,
+		any:    prefix && s.Op == db.SearchOr,
+	}
+}
+
+func (q query) matches(word string) bool {
+	for _, w := range q.words {
+		if word == w || q.prefix && strings.HasPrefix(word, w) {
+			return true
+		}
+	}
+	return false
+}
+
+type posting struct {
+	word string
+	doc  int
+}
+
+// textIndex finds the rows of a table by the words of one of its columns.
+// Docs are positions in the table slice, which is ordered by id.
+type textIndex struct {
+	postings []posting
+}
+
+func newTextIndex(n int, text func(doc int) string) textIndex {
+	var postings []posting
+	for doc := range n {
+		for _, word := range db.Tokenize(text(doc)) {
+			postings = append(postings, posting{word, doc})
+		}
+	}
+
+	slices.SortFunc(postings, func(a, b posting) int {
+		if c := strings.Compare(a.word, b.word); c != 0 {
+			return c
+		}
+		return a.doc - b.doc
+	})
+
+	return textIndex{postings: slices.Compact(postings)}
+}
+
+// search returns the matching docs, the ones matching the most query words
+// first, standing in for ts_rank, then in id order.
+func (x textIndex) search(q query) []int {
+	if len(q.words) == 0 {
+		return nil
+	}
+
+	hits := map[int]int{}
+	for _, w := range q.words {
+		i, _ := slices.BinarySearchFunc(x.postings, w, func(p posting, w string) int {
+			return strings.Compare(p.word, w)
+		})
+
+		seen := map[int]bool{}
+		for ; i < len(x.postings); i++ {
+			p := x.postings[i]
+			if p.word != w && !(q.prefix && strings.HasPrefix(p.word, w)) {
+				break
+			}
+			if !seen[p.doc] {
+				seen[p.doc] = true
+				hits[p.doc]++
+			}
+		}
+	}
+
+	docs := make([]int, 0, len(hits))
+	for doc, n := range hits {
+		if q.any || n == len(q.words) {
+			docs = append(docs, doc)
+		}
+	}
+
+	slices.SortFunc(docs, func(a, b int) int {
+		if hits[a] != hits[b] {
+			return hits[b] - hits[a]
+		}
+		return a - b
+	})
+
+	return docs
+}
+
+// headline wraps the words of text that match q in <b></b>, the default
+// ts_headline markup. The texts searched are short enough that ts_headline
+// returns them whole.
+func headline(text string, q query) string {
+	var b strings.Builder
+	b.Grow(len(text) + 16)
+
+	isWord := func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r)
+	}
+
+	for len(text) > 0 {
+		end := 0
+		for end < len(text) {
+			r, size := utf8.DecodeRuneInString(text[end:])
+			if !isWord(r) {
+				break
+			}
+			end += size
+		}
+
+		if end == 0 {
+			_, size := utf8.DecodeRuneInString(text)
+			b.WriteString(text[:size])
+			text = text[size:]
+			continue
+		}
+
+		if word := text[:end]; q.matches(strings.ToLower(word)) {
+			b.WriteString("<b>")
+			b.WriteString(word)
+			b.WriteString("</b>")
+		} else {
+			b.WriteString(word)
+		}
+		text = text[end:]
+	}
+
+	return b.String()
+}
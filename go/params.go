@@ -0,0 +1,172 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"perf-drizzle/go/db"
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	// maxPageSize caps the limit of every list route.
+	maxPageSize = 500
+	// maxTermLength caps the raw search term, in bytes.
+	maxTermLength = 256
+)
+
+// intParam describes an int32 query parameter. Optional parameters fall back
+// to def when absent; a present value must always parse and lie in [min, max].
+type intParam struct {
+	name     string
+	required bool
+	def      int32
+	min      int32
+	max      int32
+}
+
+var (
+	idParam     = intParam{name: "id", required: true, min: 1, max: math.MaxInt32}
+	limitParam  = intParam{name: "limit", def: 50, min: 0, max: maxPageSize}
+	offsetParam = intParam{name: "offset", def: 0, min: 0, max: math.MaxInt32}
+)
+
+// stringParam describes a string query parameter. When oneOf is set the value
+// must be one of its entries.
+type stringParam struct {
+	name     string
+	required bool
+	def      string
+	maxLen   int
+	oneOf    []string
+}
+
+var (
+	termParam       = stringParam{name: "term", required: true, maxLen: maxTermLength}
+	searchModeParam = stringParam{
+		name:  "mode",
+		def:   string(db.SearchPrefix),
+		oneOf: []string{string(db.SearchPrefix), string(db.SearchPlain), string(db.SearchPhrase), string(db.SearchWebsearch)},
+	}
+	searchOpParam = stringParam{
+		name:  "op",
+		def:   string(db.SearchAnd),
+		oneOf: []string{string(db.SearchAnd), string(db.SearchOr)},
+	}
+)
+
+// boolParam describes an optional true/false query parameter.
+type boolParam struct {
+	name string
+	def  bool
+}
+
+var highlightParam = boolParam{name: "highlight"}
+
+func (p intParam) get(c fiber.Ctx) (int32, error) {
+	val := c.Request().URI().QueryArgs().Peek(p.name)
+	if len(val) == 0 {
+		if p.required {
+			return 0, paramError(p.name, "is required")
+		}
+
+		return p.def, nil
+	}
+
+	// ParseUint rejects signs, so anything below zero fails here as well.
+	n, err := fasthttp.ParseUint(val)
+	if err != nil {
+		return 0, paramError(p.name, "must be a non-negative integer")
+	}
+
+	if n < int(p.min) || n > int(p.max) {
+		return 0, paramError(p.name, fmt.Sprintf("must be between %d and %d", p.min, p.max))
+	}
+
+	return int32(n), nil
+}
+
+func (p stringParam) get(c fiber.Ctx) (string, error) {
+	val := c.Query(p.name)
+	if val == "" {
+		if p.required {
+			return "", paramError(p.name, "is required")
+		}
+
+		return p.def, nil
+	}
+
+	if p.maxLen > 0 && len(val) > p.maxLen {
+		return "", paramError(p.name, fmt.Sprintf("must be at most %d bytes", p.maxLen))
+	}
+
+	if p.oneOf != nil && !slices.Contains(p.oneOf, val) {
+		return "", paramError(p.name, "must be one of "+strings.Join(p.oneOf, ", "))
+	}
+
+	return val, nil
+}
+
+func (p boolParam) get(c fiber.Ctx) (bool, error) {
+	switch c.Query(p.name) {
+	case "":
+		return p.def, nil
+	case "true", "1":
+		return true, nil
+	case "false", "0":
+		return false, nil
+	default:
+		return false, paramError(p.name, "must be true or false")
+	}
+}
+
+func pagination(c fiber.Ctx) (limit, offset int32, err error) {
+	if limit, err = limitParam.get(c); err != nil {
+		return 0, 0, err
+	}
+
+	if offset, err = offsetParam.get(c); err != nil {
+		return 0, 0, err
+	}
+
+	return limit, offset, nil
+}
+
+func search(c fiber.Ctx) (db.Search, error) {
+	term, err := termParam.get(c)
+	if err != nil {
+		return db.Search{}, err
+	}
+
+	mode, err := searchModeParam.get(c)
+	if err != nil {
+		return db.Search{}, err
+	}
+
+	op, err := searchOpParam.get(c)
+	if err != nil {
+		return db.Search{}, err
+	}
+
+	s, err := db.NewSearch(term, db.SearchMode(mode), db.SearchOp(op))
+	if errors.Is(err, db.ErrEmptySearch) {
+		return db.Search{}, paramError(termParam.name, "must contain at least one letter or digit")
+	} else if err != nil {
+		return db.Search{}, paramError(termParam.name, err.Error())
+	}
+
+	return s, nil
+}
+
+func paramError(name, reason string) *APIError {
+	return &APIError{
+		Status:  fiber.StatusBadRequest,
+		Code:    codeInvalidInput,
+		Message: fmt.Sprintf("query parameter %q %s", name, reason),
+		Param:   name,
+	}
+}
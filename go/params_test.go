@@ -0,0 +1,185 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/valyala/fasthttp"
+)
+
+// withQuery runs f with a context for a request to /?query.
+func withQuery(t *testing.T, query string, f func(c fiber.Ctx)) {
+	t.Helper()
+
+	app := fiber.New()
+	fctx := &fasthttp.RequestCtx{}
+	fctx.Request.SetRequestURI("/?" + query)
+
+	c := app.AcquireCtx(fctx)
+	defer app.ReleaseCtx(c)
+
+	f(c)
+}
+
+// checkParamError fails unless err is a 400 naming param, or is nil when
+// param is empty.
+func checkParamError(t *testing.T, err error, param string) {
+	t.Helper()
+
+	if param == "" {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("error = %v, want an APIError for %q", err, param)
+	}
+	if apiErr.Status != fiber.StatusBadRequest || apiErr.Code != codeInvalidInput || apiErr.Param != param {
+		t.Fatalf("error = %+v, want a 400 for %q", apiErr, param)
+	}
+}
+
+func TestIntParam(t *testing.T) {
+	tests := []struct {
+		name    string
+		param   intParam
+		query   string
+		want    int32
+		wantErr bool
+	}{
+		{"required present", idParam, "id=7", 7, false},
+		{"required missing", idParam, "", 0, true},
+		{"required empty", idParam, "id=", 0, true},
+		{"below min", idParam, "id=0", 0, true},
+		{"max int32", idParam, "id=2147483647", 2147483647, false},
+		{"above int32", idParam, "id=2147483648", 0, true},
+		{"negative", idParam, "id=-1", 0, true},
+		{"signed", idParam, "id=+1", 0, true},
+		{"not a number", idParam, "id=abc", 0, true},
+		{"trailing garbage", idParam, "id=1x", 0, true},
+		{"default", limitParam, "", 50, false},
+		{"zero limit", limitParam, "limit=0", 0, false},
+		{"max page size", limitParam, "limit=500", maxPageSize, false},
+		{"above max page size", limitParam, "limit=501", 0, true},
+		{"default offset", offsetParam, "", 0, false},
+		{"offset", offsetParam, "offset=100", 100, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withQuery(t, tt.query, func(c fiber.Ctx) {
+				got, err := tt.param.get(c)
+				if tt.wantErr {
+					checkParamError(t, err, tt.param.name)
+					return
+				}
+
+				checkParamError(t, err, "")
+				if got != tt.want {
+					t.Errorf("got %d, want %d", got, tt.want)
+				}
+			})
+		})
+	}
+}
+
+func TestStringParam(t *testing.T) {
+	tests := []struct {
+		name    string
+		param   stringParam
+		query   string
+		want    string
+		wantErr bool
+	}{
+		{"required present", termParam, "term=ve", "ve", false},
+		{"required missing", termParam, "", "", true},
+		{"unescaped", termParam, "term=a%20b%26c", "a b&c", false},
+		{"max length", termParam, "term=" + strings.Repeat("a", maxTermLength), strings.Repeat("a", maxTermLength), false},
+		{"too long", termParam, "term=" + strings.Repeat("a", maxTermLength+1), "", true},
+		{"default", searchModeParam, "", "prefix", false},
+		{"one of", searchModeParam, "mode=websearch", "websearch", false},
+		{"not one of", searchModeParam, "mode=regex", "", true},
+		{"case sensitive", searchOpParam, "op=AND", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withQuery(t, tt.query, func(c fiber.Ctx) {
+				got, err := tt.param.get(c)
+				if tt.wantErr {
+					checkParamError(t, err, tt.param.name)
+					return
+				}
+
+				checkParamError(t, err, "")
+				if got != tt.want {
+					t.Errorf("got %q, want %q", got, tt.want)
+				}
+			})
+		})
+	}
+}
+
+func TestBoolParam(t *testing.T) {
+	tests := []struct {
+		query   string
+		want    bool
+		wantErr bool
+	}{
+		{"", false, false},
+		{"highlight=true", true, false},
+		{"highlight=1", true, false},
+		{"highlight=false", false, false},
+		{"highlight=0", false, false},
+		{"highlight=yes", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			withQuery(t, tt.query, func(c fiber.Ctx) {
+				got, err := highlightParam.get(c)
+				if tt.wantErr {
+					checkParamError(t, err, highlightParam.name)
+					return
+				}
+
+				checkParamError(t, err, "")
+				if got != tt.want {
+					t.Errorf("got %t, want %t", got, tt.want)
+				}
+			})
+		})
+	}
+}
+
+func TestSearchParams(t *testing.T) {
+	tests := []struct {
+		query     string
+		wantQuery string
+		wantParam string
+	}{
+		{"term=ve", "'ve':*", ""},
+		{"term=a%20b&op=or", "'a':* | 'b':*", ""},
+		{"term=a%20b&mode=plain", "a b", ""},
+		{"term=%26%7C!", "", "term"},
+		{"term=ve&mode=fuzzy", "", "mode"},
+		{"term=ve&op=xor", "", "op"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			withQuery(t, tt.query, func(c fiber.Ctx) {
+				s, err := search(c)
+				checkParamError(t, err, tt.wantParam)
+				if err == nil && s.Query() != tt.wantQuery {
+					t.Errorf("query = %q, want %q", s.Query(), tt.wantQuery)
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package rawdb
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// kind is the Postgres type of a result column, which fixes both its binary
+// layout and how it is written as JSON.
+type kind uint8
+
+const (
+	int4 kind = iota
+	float4
+	float8
+	text
+	date
+	json
+)
+
+func (k kind) accepts(oid uint32) bool {
+	switch k {
+	case int4:
+		return oid == pgtype.Int4OID
+	case float4:
+		return oid == pgtype.Float4OID
+	case float8:
+		return oid == pgtype.Float8OID
+	case text:
+		return oid == pgtype.TextOID || oid == pgtype.VarcharOID
+	case date:
+		return oid == pgtype.DateOID
+	case json:
+		return oid == pgtype.JSONOID
+	default:
+		return false
+	}
+}
+
+type column struct {
+	key  string
+	kind kind
+}
+
+// object describes the result columns of a query, in order, under the JSON
+// keys of the sqlc row they are scanned into.
+type object struct {
+	columns []column
+	// prefixes hold the punctuation and quoted key written before each value,
+	// from `{"id":` to `,"name":`.
+	prefixes []string
+}
+
+func newObject(columns ...column) object {
+	prefixes := make([]string, len(columns))
+	for i, c := range columns {
+		sep := ","
+		if i == 0 {
+			sep = "{"
+		}
+		prefixes[i] = sep + `"` + c.key + `":`
+	}
+
+	return object{columns: columns, prefixes: prefixes}
+}
+
+// with returns o extended by more columns.
+func (o object) with(more ...column) object {
+	return newObject(slices.Concat(o.columns, more)...)
+}
+
+// check makes sure the query returned the columns o describes, so the values
+// can be decoded without looking at their length.
+func (o object) check(fields []pgconn.FieldDescription) error {
+	if len(fields) != len(o.columns) {
+		return fmt.Errorf("rawdb: query returned %d columns, expected %d", len(fields), len(o.columns))
+	}
+
+	for i, f := range fields {
+		if !o.columns[i].kind.accepts(f.DataTypeOID) {
+			return fmt.Errorf("rawdb: column %q has type oid %d, which does not fit %q", f.Name, f.DataTypeOID, o.columns[i].key)
+		}
+	}
+
+	return nil
+}
+
+// appendJSON writes the binary values of one row as a JSON object.
+func (o object) appendJSON(b []byte, values [][]byte) ([]byte, error) {
+	for i, v := range values {
+		b = append(b, o.prefixes[i]...)
+
+		if v == nil {
+			b = append(b, "null"...)
+			continue
+		}
+
+		var err error
+		switch o.columns[i].kind {
+		case int4:
+			b = appendInt4(b, v)
+		case float4:
+			b, err = appendFloat(b, float64(decodeFloat4(v)), 32)
+		case float8:
+			b, err = appendFloat(b, decodeFloat8(v), 64)
+		case text:
+			b = appendString(b, v)
+		case date:
+			b = decodeDate(v).AppendJSON(b)
+		case json:
+			b = append(b, v...)
+		}
+		if err != nil {
+			return b, fmt.Errorf("rawdb: column %q: %w", o.columns[i].key, err)
+		}
+	}
+
+	return append(b, '}'), nil
+}
+
+var (
+	customerObject = newObject(
+		column{"id", int4},
+		column{"companyName", text},
+		column{"contactName", text},
+		column{"contactTitle", text},
+		column{"address", text},
+		column{"city", text},
+		column{"postalCode", text},
+		column{"region", text},
+		column{"country", text},
+		column{"phone", text},
+		column{"fax", text},
+	)
+
+	customerHeadlineObject = customerObject.with(column{"headline", text})
+
+	employeeObject = newObject(
+		column{"id", int4},
+		column{"lastName", text},
+		column{"firstName", text},
+		column{"title", text},
+		column{"titleOfCourtesy", text},
+		column{"birthDate", date},
+		column{"hireDate", date},
+		column{"address", text},
+		column{"city", text},
+		column{"postalCode", text},
+		column{"country", text},
+		column{"homePhone", text},
+		column{"extension", int4},
+		column{"notes", text},
+		column{"recipientId", int4},
+	)
+
+	employeeWithRecipientObject = employeeObject.with(column{"recipient", json})
+
+	supplierObject = newObject(
+		column{"id", int4},
+		column{"companyName", text},
+		column{"contactName", text},
+		column{"contactTitle", text},
+		column{"address", text},
+		column{"city", text},
+		column{"region", text},
+		column{"postalCode", text},
+		column{"country", text},
+		column{"phone", text},
+	)
+
+	productObject = newObject(
+		column{"id", int4},
+		column{"name", text},
+		column{"quantityPerUnit", text},
+		column{"unitPrice", float8},
+		column{"unitsInStock", int4},
+		column{"unitsOnOrder", int4},
+		column{"reorderLevel", int4},
+		column{"discontinued", int4},
+		column{"supplierId", int4},
+	)
+
+	productWithSupplierObject = productObject.with(column{"supplier", json})
+
+	productHeadlineObject = productObject.with(column{"headline", text})
+
+	orderTotalsObject = newObject(
+		column{"id", int4},
+		column{"shippedDate", date},
+		column{"shipName", text},
+		column{"shipCity", text},
+		column{"shipCountry", text},
+		column{"productsCount", int4},
+		column{"quantitySum", int4},
+		column{"totalPrice", float4},
+	)
+
+	orderWithDetailsAndProductsObject = newObject(
+		column{"id", int4},
+		column{"orderDate", date},
+		column{"requiredDate", date},
+		column{"shippedDate", date},
+		column{"shipVia", int4},
+		column{"freight", float8},
+		column{"shipName", text},
+		column{"shipCity", text},
+		column{"shipRegion", text},
+		column{"shipPostalCode", text},
+		column{"shipCountry", text},
+		column{"customerId", int4},
+		column{"employeeId", int4},
+		column{"details", json},
+	)
+)
@@ -0,0 +1,153 @@
+package rawdb
+
+import (
+	"encoding/binary"
+	"math"
+	"perf-drizzle/go/db"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// wire encodes the fields of row, in order, as Postgres sends them in the
+// binary format, with nil for a nil pointer, an invalid date or absent json.
+func wire(t *testing.T, row any) [][]byte {
+	t.Helper()
+
+	v := reflect.ValueOf(row)
+	values := make([][]byte, v.NumField())
+	for i := range values {
+		f := v.Field(i)
+		if f.Kind() == reflect.Pointer {
+			if f.IsNil() {
+				continue
+			}
+			f = f.Elem()
+		}
+
+		switch x := f.Interface().(type) {
+		case int32:
+			values[i] = binary.BigEndian.AppendUint32(nil, uint32(x))
+		case float32:
+			values[i] = binary.BigEndian.AppendUint32(nil, math.Float32bits(x))
+		case float64:
+			values[i] = binary.BigEndian.AppendUint64(nil, math.Float64bits(x))
+		case string:
+			values[i] = []byte(x)
+		case db.RawJSON:
+			values[i] = x
+		case db.Date:
+			if !x.Valid {
+				continue
+			}
+			days := int64(math.MaxInt32)
+			switch x.InfinityModifier {
+			case pgtype.NegativeInfinity:
+				days = math.MinInt32
+			case pgtype.Finite:
+				days = (x.Time.Unix() - time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC).Unix()) / (24 * 60 * 60)
+			}
+			values[i] = binary.BigEndian.AppendUint32(nil, uint32(int32(days)))
+		default:
+			t.Fatalf("field %s: no wire format for %T", v.Type().Field(i).Name, x)
+		}
+	}
+
+	return values
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func day(year int, month time.Month, d int) db.Date {
+	return db.Date{Date: pgtype.Date{Time: time.Date(year, month, d, 0, 0, 0, 0, time.UTC), Valid: true}}
+}
+
+func TestAppendJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		obj  object
+		row  any
+	}{
+		{"customer", customerObject, db.Customer{
+			ID: 1, CompanyName: "Alfreds Futterkiste", ContactName: "Maria Anders", ContactTitle: "Sales Representative",
+			Address: "Obere Str. 57", City: "Berlin", PostalCode: ptr("12209"), Country: "Germany", Phone: "030-0074321", Fax: ptr("030-0076545"),
+		}},
+		{"quotes and backslashes", customerObject, db.Customer{
+			CompanyName: `"Quoted" Co`, ContactName: `back\slash \\ \"`, ContactTitle: `'single'`, Address: `/slash/`,
+		}},
+		{"control characters", customerObject, db.Customer{
+			CompanyName: "tab\tnew\nline\rreturn", ContactName: "\x00\x01\x1f\x7f", ContactTitle: "\b\f", Address: "\x1b[0m",
+		}},
+		{"non-ASCII", customerObject, db.Customer{
+			CompanyName: "Île-de-France", ContactName: "Ñandú Ødegaard", ContactTitle: "北京市", Address: "🦊 emoji", City: "Ελλάδα",
+		}},
+		{"nulls", customerObject, db.Customer{ID: math.MinInt32, PostalCode: nil, Region: nil, Fax: nil}},
+		{"empty strings", customerObject, db.Customer{ID: math.MaxInt32, PostalCode: ptr(""), Region: ptr(""), Fax: ptr("")}},
+		{"headline", customerHeadlineObject, db.SearchCustomerHeadlineRow{ID: 1, CompanyName: "Vins et alcools", Headline: "<b>Vins</b> et alcools & co"}},
+		{"employee", employeeObject, db.EmployeesRow{
+			ID: 1, LastName: "Davolio", FirstName: ptr("Nancy"), BirthDate: day(1968, time.December, 8), HireDate: day(2016, time.May, 1),
+			Extension: 5467, Notes: "Education includes a BA\nin psychology.", RecipientId: ptr[int32](2),
+		}},
+		{"dates", employeeObject, db.EmployeesRow{BirthDate: day(1999, time.December, 31), HireDate: day(2000, time.January, 1)}},
+		{"leap day and far dates", employeeObject, db.EmployeesRow{BirthDate: day(2024, time.February, 29), HireDate: day(1, time.January, 1)}},
+		{"infinity dates", employeeObject, db.EmployeesRow{
+			BirthDate: db.Date{Date: pgtype.Date{InfinityModifier: pgtype.Infinity, Valid: true}},
+			HireDate:  db.Date{Date: pgtype.Date{InfinityModifier: pgtype.NegativeInfinity, Valid: true}},
+		}},
+		{"null dates", employeeObject, db.EmployeesRow{FirstName: nil, RecipientId: nil}},
+		{"recipient", employeeWithRecipientObject, db.EmployeeWithRecipientRow{ID: 3, Recipient: db.RawJSON(`{"id":2,"lastName":"Fuller","birthDate":"1952-02-19T00:00:00.000Z"}`)}},
+		{"no recipient", employeeWithRecipientObject, db.EmployeeWithRecipientRow{ID: 2}},
+		{"product", productObject, db.ProductsRow{ID: 1, Name: "Chai", QuantityPerUnit: "10 boxes x 20 bags", UnitPrice: 18, UnitsInStock: 39, ReorderLevel: 10, SupplierId: 1}},
+		{"float64 fractions", productObject, db.ProductsRow{UnitPrice: 0.1}},
+		{"float64 just below 1e21", productObject, db.ProductsRow{UnitPrice: math.Nextafter(1e21, 0)}},
+		{"float64 1e21", productObject, db.ProductsRow{UnitPrice: 1e21}},
+		{"float64 1e-6", productObject, db.ProductsRow{UnitPrice: 1e-6}},
+		{"float64 just below 1e-6", productObject, db.ProductsRow{UnitPrice: math.Nextafter(1e-6, 0)}},
+		{"float64 1e-7", productObject, db.ProductsRow{UnitPrice: 1e-7}},
+		{"float64 negative exponent", productObject, db.ProductsRow{UnitPrice: -1.5e-300}},
+		{"float64 largest", productObject, db.ProductsRow{UnitPrice: math.MaxFloat64}},
+		{"float64 smallest", productObject, db.ProductsRow{UnitPrice: math.SmallestNonzeroFloat64}},
+		{"float64 negative zero", productObject, db.ProductsRow{UnitPrice: math.Copysign(0, -1)}},
+		{"order totals", orderTotalsObject, db.OrdersWithDetailsRow{ID: 10248, ShippedDate: day(1996, time.July, 16), ShipName: "Vins et alcools Chevalier", ProductsCount: 3, QuantitySum: 27, TotalPrice: 440}},
+		{"float32 fractions", orderTotalsObject, db.OrdersWithDetailsRow{TotalPrice: 0.1}},
+		{"float32 cents", orderTotalsObject, db.OrdersWithDetailsRow{TotalPrice: 1863.4}},
+		{"float32 just below 1e21", orderTotalsObject, db.OrdersWithDetailsRow{TotalPrice: math.Nextafter32(1e21, 0)}},
+		{"float32 1e21", orderTotalsObject, db.OrdersWithDetailsRow{TotalPrice: 1e21}},
+		{"float32 1e-6", orderTotalsObject, db.OrdersWithDetailsRow{TotalPrice: 1e-6}},
+		{"float32 just below 1e-6", orderTotalsObject, db.OrdersWithDetailsRow{TotalPrice: math.Nextafter32(1e-6, 0)}},
+		{"float32 largest", orderTotalsObject, db.OrdersWithDetailsRow{TotalPrice: math.MaxFloat32}},
+		{"float32 smallest", orderTotalsObject, db.OrdersWithDetailsRow{TotalPrice: math.SmallestNonzeroFloat32}},
+		{"null shipped date", orderTotalsObject, db.OrdersWithDetailsRow{ID: 11077}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, err := sonic.Marshal(tt.row)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := tt.obj.appendJSON([]byte("prefix"), wire(t, tt.row))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != "prefix"+string(want) {
+				t.Errorf("appendJSON\n got %s\nwant prefix%s", got, want)
+			}
+		})
+	}
+}
+
+func TestAppendJSONRejectsNonFinite(t *testing.T) {
+	for _, f := range []float64{math.Inf(1), math.Inf(-1), math.NaN()} {
+		row := db.ProductsRow{UnitPrice: f}
+		if _, err := productObject.appendJSON(nil, wire(t, row)); err == nil {
+			t.Errorf("appendJSON of %v: no error", f)
+		}
+	}
+}
@@ -0,0 +1,304 @@
+// Package rawdb is the ceiling the other backends are measured against: the
+// queries of queries.sql run over pgx with every result column in the binary
+// format, read with RawValues instead of Scan. The typed Store methods decode
+// the values straight into slices preallocated from the limit, and the
+// db.Encoder methods write them as JSON without any intermediate struct.
+package rawdb
+
+import (
+	"context"
+	"fmt"
+	"perf-drizzle/go/db"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// binaryResults asks for every result column in the binary format, which for
+// text and json is the text itself.
+var binaryResults = pgx.QueryResultFormats{pgx.BinaryFormatCode}
+
+type Store struct {
+	pool *pgxpool.Pool
+}
+
+func New(databaseUrl string, poolConfig db.PoolConfig) (*Store, error) {
+	config, err := pgxpool.ParseConfig(databaseUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	poolConfig.Apply(config)
+
+	pool, err := pgxpool.NewWithConfig(context.Background(), config)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err = pool.Ping(ctx); err != nil {
+		pool.Close()
+
+		return nil, fmt.Errorf("unable to ping database: %w", err)
+	}
+
+	return &Store{pool: pool}, nil
+}
+
+func (s *Store) Ping(ctx context.Context) error {
+	return s.pool.Ping(ctx)
+}
+
+func (s *Store) Stat() *pgxpool.Stat {
+	return s.pool.Stat()
+}
+
+func (s *Store) Close() {
+	s.pool.Close()
+}
+
+// each runs sql and hands fn the raw values of every row, which are only
+// valid until fn returns.
+func (s *Store) each(ctx context.Context, obj object, fn func(values [][]byte) error, sql string, args ...any) error {
+	conn, err := db.Acquire(ctx, s.pool)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	rows, err := conn.Query(ctx, sql, append([]any{binaryResults}, args...)...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	if err := obj.check(rows.FieldDescriptions()); err != nil {
+		return err
+	}
+
+	for rows.Next() {
+		if err := fn(rows.RawValues()); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// list decodes the rows of sql into a slice with room for size of them.
+func list[T any](ctx context.Context, s *Store, obj object, size int32, decode func(r *values) T, sql string, args ...any) ([]T, error) {
+	items := make([]T, 0, size)
+	err := s.each(ctx, obj, func(v [][]byte) error {
+		items = append(items, decode(&values{v: v}))
+		return nil
+	}, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// one decodes the single row of sql, or fails with pgx.ErrNoRows like a sqlc
+// :one query.
+func one[T any](ctx context.Context, s *Store, obj object, decode func(r *values) T, sql string, args ...any) (T, error) {
+	items, err := list(ctx, s, obj, 1, decode, sql, args...)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	if len(items) == 0 {
+		var zero T
+		return zero, pgx.ErrNoRows
+	}
+
+	return items[0], nil
+}
+
+// appendArray writes the rows of sql to buf as a JSON array. Once the first
+// row shows how wide they are, buf grows to fit size of them in one go.
+func (s *Store) appendArray(ctx context.Context, buf []byte, obj object, size int32, sql string, args ...any) ([]byte, error) {
+	buf = append(buf, '[')
+	start := len(buf)
+
+	err := s.each(ctx, obj, func(v [][]byte) error {
+		first := len(buf) == start
+		if !first {
+			buf = append(buf, ',')
+		}
+
+		n := len(buf)
+		var err error
+		if buf, err = obj.appendJSON(buf, v); err != nil {
+			return err
+		}
+
+		if first && size > 1 {
+			buf = slices.Grow(buf, (len(buf)-n+1)*int(size-1)+1)
+		}
+		return nil
+	}, sql, args...)
+	if err != nil {
+		return buf, err
+	}
+
+	return append(buf, ']'), nil
+}
+
+// appendOne writes the single row of sql to buf as a JSON object, or fails
+// with pgx.ErrNoRows.
+func (s *Store) appendOne(ctx context.Context, buf []byte, obj object, sql string, args ...any) ([]byte, error) {
+	start := len(buf)
+
+	err := s.each(ctx, obj, func(v [][]byte) error {
+		if len(buf) > start {
+			return nil
+		}
+
+		var err error
+		buf, err = obj.appendJSON(buf, v)
+		return err
+	}, sql, args...)
+	if err == nil && len(buf) == start {
+		err = pgx.ErrNoRows
+	}
+
+	return buf, err
+}
+
+func (s *Store) Customers(ctx context.Context, arg db.CustomersParams) ([]db.Customer, error) {
+	return list(ctx, s, customerObject, arg.Limit, decodeCustomer, db.CustomersSQL, arg.Limit, arg.Offset)
+}
+
+func (s *Store) CustomerById(ctx context.Context, id int32) (db.Customer, error) {
+	return one(ctx, s, customerObject, decodeCustomer, db.CustomerByIdSQL, id)
+}
+
+func (s *Store) SearchCustomer(ctx context.Context, arg db.SearchParams) ([]db.Customer, error) {
+	return list(ctx, s, customerObject, arg.Limit, decodeCustomer, db.SearchCustomerSQL, string(arg.Mode), arg.Query(), arg.Offset, arg.Limit)
+}
+
+func (s *Store) SearchCustomerHeadline(ctx context.Context, arg db.SearchParams) ([]db.SearchCustomerHeadlineRow, error) {
+	return list(ctx, s, customerHeadlineObject, arg.Limit, decodeCustomerHeadline, db.SearchCustomerHeadlineSQL, string(arg.Mode), arg.Query(), arg.Offset, arg.Limit)
+}
+
+func (s *Store) Employees(ctx context.Context, arg db.EmployeesParams) ([]db.EmployeesRow, error) {
+	return list(ctx, s, employeeObject, arg.Limit, decodeEmployee, db.EmployeesSQL, arg.Limit, arg.Offset)
+}
+
+func (s *Store) EmployeeWithRecipient(ctx context.Context, id int32) ([]db.EmployeeWithRecipientRow, error) {
+	return list(ctx, s, employeeWithRecipientObject, 1, decodeEmployeeWithRecipient, db.EmployeeWithRecipientSQL, id)
+}
+
+func (s *Store) Suppliers(ctx context.Context, arg db.SuppliersParams) ([]db.Supplier, error) {
+	return list(ctx, s, supplierObject, arg.Limit, decodeSupplier, db.SuppliersSQL, arg.Limit, arg.Offset)
+}
+
+func (s *Store) SupplierById(ctx context.Context, id int32) (db.Supplier, error) {
+	return one(ctx, s, supplierObject, decodeSupplier, db.SupplierByIdSQL, id)
+}
+
+func (s *Store) Products(ctx context.Context, arg db.ProductsParams) ([]db.ProductsRow, error) {
+	return list(ctx, s, productObject, arg.Limit, decodeProduct, db.ProductsSQL, arg.Limit, arg.Offset)
+}
+
+func (s *Store) ProductWithSupplier(ctx context.Context, id int32) ([]db.ProductWithSupplierRow, error) {
+	return list(ctx, s, productWithSupplierObject, 1, decodeProductWithSupplier, db.ProductWithSupplierSQL, id)
+}
+
+func (s *Store) SearchProduct(ctx context.Context, arg db.SearchParams) ([]db.SearchProductRow, error) {
+	return list(ctx, s, productObject, arg.Limit, func(r *values) db.SearchProductRow {
+		return db.SearchProductRow(decodeProduct(r))
+	}, db.SearchProductSQL, string(arg.Mode), arg.Query(), arg.Offset, arg.Limit)
+}
+
+func (s *Store) SearchProductHeadline(ctx context.Context, arg db.SearchParams) ([]db.SearchProductHeadlineRow, error) {
+	return list(ctx, s, productHeadlineObject, arg.Limit, decodeProductHeadline, db.SearchProductHeadlineSQL, string(arg.Mode), arg.Query(), arg.Offset, arg.Limit)
+}
+
+func (s *Store) OrdersWithDetails(ctx context.Context, arg db.OrdersWithDetailsParams) ([]db.OrdersWithDetailsRow, error) {
+	return list(ctx, s, orderTotalsObject, arg.Limit, decodeOrderTotals, db.OrdersWithDetailsSQL, arg.Limit, arg.Offset)
+}
+
+func (s *Store) OrderWithDetails(ctx context.Context, id int32) ([]db.OrderWithDetailsRow, error) {
+	return list(ctx, s, orderTotalsObject, 1, func(r *values) db.OrderWithDetailsRow {
+		return db.OrderWithDetailsRow(decodeOrderTotals(r))
+	}, db.OrderWithDetailsSQL, id)
+}
+
+func (s *Store) OrderWithDetailsAndProducts(ctx context.Context, id int32) ([]db.OrderWithDetailsAndProductsRow, error) {
+	return list(ctx, s, orderWithDetailsAndProductsObject, 1, decodeOrderWithDetailsAndProducts, db.OrderWithDetailsAndProductsSQL, id)
+}
+
+func (s *Store) AppendCustomers(ctx context.Context, buf []byte, arg db.CustomersParams) ([]byte, error) {
+	return s.appendArray(ctx, buf, customerObject, arg.Limit, db.CustomersSQL, arg.Limit, arg.Offset)
+}
+
+func (s *Store) AppendCustomerById(ctx context.Context, buf []byte, id int32) ([]byte, error) {
+	return s.appendOne(ctx, buf, customerObject, db.CustomerByIdSQL, id)
+}
+
+func (s *Store) AppendSearchCustomer(ctx context.Context, buf []byte, arg db.SearchParams) ([]byte, error) {
+	return s.appendArray(ctx, buf, customerObject, arg.Limit, db.SearchCustomerSQL, string(arg.Mode), arg.Query(), arg.Offset, arg.Limit)
+}
+
+func (s *Store) AppendSearchCustomerHeadline(ctx context.Context, buf []byte, arg db.SearchParams) ([]byte, error) {
+	return s.appendArray(ctx, buf, customerHeadlineObject, arg.Limit, db.SearchCustomerHeadlineSQL, string(arg.Mode), arg.Query(), arg.Offset, arg.Limit)
+}
+
+func (s *Store) AppendEmployees(ctx context.Context, buf []byte, arg db.EmployeesParams) ([]byte, error) {
+	return s.appendArray(ctx, buf, employeeObject, arg.Limit, db.EmployeesSQL, arg.Limit, arg.Offset)
+}
+
+func (s *Store) AppendEmployeeWithRecipient(ctx context.Context, buf []byte, id int32) ([]byte, error) {
+	return s.appendArray(ctx, buf, employeeWithRecipientObject, 1, db.EmployeeWithRecipientSQL, id)
+}
+
+func (s *Store) AppendSuppliers(ctx context.Context, buf []byte, arg db.SuppliersParams) ([]byte, error) {
+	return s.appendArray(ctx, buf, supplierObject, arg.Limit, db.SuppliersSQL, arg.Limit, arg.Offset)
+}
+
+func (s *Store) AppendSupplierById(ctx context.Context, buf []byte, id int32) ([]byte, error) {
+	return s.appendOne(ctx, buf, supplierObject, db.SupplierByIdSQL, id)
+}
+
+func (s *Store) AppendProducts(ctx context.Context, buf []byte, arg db.ProductsParams) ([]byte, error) {
+	return s.appendArray(ctx, buf, productObject, arg.Limit, db.ProductsSQL, arg.Limit, arg.Offset)
+}
+
+func (s *Store) AppendProductWithSupplier(ctx context.Context, buf []byte, id int32) ([]byte, error) {
+	return s.appendArray(ctx, buf, productWithSupplierObject, 1, db.ProductWithSupplierSQL, id)
+}
+
+func (s *Store) AppendSearchProduct(ctx context.Context, buf []byte, arg db.SearchParams) ([]byte, error) {
+	return s.appendArray(ctx, buf, productObject, arg.Limit, db.SearchProductSQL, string(arg.Mode), arg.Query(), arg.Offset, arg.Limit)
+}
+
+func (s *Store) AppendSearchProductHeadline(ctx context.Context, buf []byte, arg db.SearchParams) ([]byte, error) {
+	return s.appendArray(ctx, buf, productHeadlineObject, arg.Limit, db.SearchProductHeadlineSQL, string(arg.Mode), arg.Query(), arg.Offset, arg.Limit)
+}
+
+func (s *Store) AppendOrdersWithDetails(ctx context.Context, buf []byte, arg db.OrdersWithDetailsParams) ([]byte, error) {
+	return s.appendArray(ctx, buf, orderTotalsObject, arg.Limit, db.OrdersWithDetailsSQL, arg.Limit, arg.Offset)
+}
+
+func (s *Store) AppendOrderWithDetails(ctx context.Context, buf []byte, id int32) ([]byte, error) {
+	return s.appendArray(ctx, buf, orderTotalsObject, 1, db.OrderWithDetailsSQL, id)
+}
+
+func (s *Store) AppendOrderWithDetailsAndProducts(ctx context.Context, buf []byte, id int32) ([]byte, error) {
+	return s.appendArray(ctx, buf, orderWithDetailsAndProductsObject, 1, db.OrderWithDetailsAndProductsSQL, id)
+}
+
+var (
+	_ db.Store       = (*Store)(nil)
+	_ db.Highlighter = (*Store)(nil)
+	_ db.Pinger      = (*Store)(nil)
+	_ db.Encoder     = (*Store)(nil)
+	_ db.PoolStatter = (*Store)(nil)
+)
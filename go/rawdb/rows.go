@@ -0,0 +1,145 @@
+package rawdb
+
+import "perf-drizzle/go/db"
+
+// Decoders for the typed Store methods, listing the fields in the column
+// order of the matching object.
+
+func decodeCustomer(r *values) db.Customer {
+	return db.Customer{
+		ID:           r.int4(),
+		CompanyName:  r.text(),
+		ContactName:  r.text(),
+		ContactTitle: r.text(),
+		Address:      r.text(),
+		City:         r.text(),
+		PostalCode:   r.textPtr(),
+		Region:       r.textPtr(),
+		Country:      r.text(),
+		Phone:        r.text(),
+		Fax:          r.textPtr(),
+	}
+}
+
+func decodeCustomerHeadline(r *values) db.SearchCustomerHeadlineRow {
+	c := decodeCustomer(r)
+	return db.SearchCustomerHeadlineRow{
+		ID:           c.ID,
+		CompanyName:  c.CompanyName,
+		ContactName:  c.ContactName,
+		ContactTitle: c.ContactTitle,
+		Address:      c.Address,
+		City:         c.City,
+		PostalCode:   c.PostalCode,
+		Region:       c.Region,
+		Country:      c.Country,
+		Phone:        c.Phone,
+		Fax:          c.Fax,
+		Headline:     r.text(),
+	}
+}
+
+func decodeEmployee(r *values) db.EmployeesRow {
+	return db.EmployeesRow{
+		ID:              r.int4(),
+		LastName:        r.text(),
+		FirstName:       r.textPtr(),
+		Title:           r.text(),
+		TitleOfCourtesy: r.text(),
+		BirthDate:       r.date(),
+		HireDate:        r.date(),
+		Address:         r.text(),
+		City:            r.text(),
+		PostalCode:      r.text(),
+		Country:         r.text(),
+		HomePhone:       r.text(),
+		Extension:       r.int4(),
+		Notes:           r.text(),
+		RecipientId:     r.int4Ptr(),
+	}
+}
+
+func decodeEmployeeWithRecipient(r *values) db.EmployeeWithRecipientRow {
+	return decodeEmployee(r).WithRecipient(r.json())
+}
+
+func decodeSupplier(r *values) db.Supplier {
+	return db.Supplier{
+		ID:           r.int4(),
+		CompanyName:  r.text(),
+		ContactName:  r.text(),
+		ContactTitle: r.text(),
+		Address:      r.text(),
+		City:         r.text(),
+		Region:       r.textPtr(),
+		PostalCode:   r.text(),
+		Country:      r.text(),
+		Phone:        r.text(),
+	}
+}
+
+func decodeProduct(r *values) db.ProductsRow {
+	return db.ProductsRow{
+		ID:              r.int4(),
+		Name:            r.text(),
+		QuantityPerUnit: r.text(),
+		UnitPrice:       r.float8(),
+		UnitsInStock:    r.int4(),
+		UnitsOnOrder:    r.int4(),
+		ReorderLevel:    r.int4(),
+		Discontinued:    r.int4(),
+		SupplierId:      r.int4(),
+	}
+}
+
+func decodeProductWithSupplier(r *values) db.ProductWithSupplierRow {
+	return decodeProduct(r).WithSupplier(r.json())
+}
+
+func decodeProductHeadline(r *values) db.SearchProductHeadlineRow {
+	p := decodeProduct(r)
+	return db.SearchProductHeadlineRow{
+		ID:              p.ID,
+		Name:            p.Name,
+		QuantityPerUnit: p.QuantityPerUnit,
+		UnitPrice:       p.UnitPrice,
+		UnitsInStock:    p.UnitsInStock,
+		UnitsOnOrder:    p.UnitsOnOrder,
+		ReorderLevel:    p.ReorderLevel,
+		Discontinued:    p.Discontinued,
+		SupplierId:      p.SupplierId,
+		Headline:        r.text(),
+	}
+}
+
+func decodeOrderTotals(r *values) db.OrdersWithDetailsRow {
+	return db.OrdersWithDetailsRow{
+		ID:            r.int4(),
+		ShippedDate:   r.date(),
+		ShipName:      r.text(),
+		ShipCity:      r.text(),
+		ShipCountry:   r.text(),
+		ProductsCount: r.int4(),
+		QuantitySum:   r.int4(),
+		TotalPrice:    r.float4(),
+	}
+}
+
+func decodeOrderWithDetailsAndProducts(r *values) db.OrderWithDetailsAndProductsRow {
+	return db.OrderWithDetailsAndProductsRow{
+		ID:             r.int4(),
+		OrderDate:      r.date(),
+		RequiredDate:   r.date(),
+		ShippedDate:    r.date(),
+		ShipVia:        r.int4(),
+		Freight:        r.float8(),
+		ShipName:       r.text(),
+		ShipCity:       r.text(),
+		ShipRegion:     r.textPtr(),
+		ShipPostalCode: r.textPtr(),
+		ShipCountry:    r.text(),
+		CustomerId:     r.int4(),
+		EmployeeId:     r.int4(),
+		Details:        r.json(),
+	}
+}
@@ -0,0 +1,183 @@
+package rawdb
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"perf-drizzle/go/db"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bytedance/sonic"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Decoders for the binary format of each kind. object.check has already
+// verified the column types, so the lengths are known to be right.
+
+func decodeInt4(v []byte) int32 {
+	return int32(binary.BigEndian.Uint32(v))
+}
+
+func decodeFloat4(v []byte) float32 {
+	return math.Float32frombits(binary.BigEndian.Uint32(v))
+}
+
+func decodeFloat8(v []byte) float64 {
+	return math.Float64frombits(binary.BigEndian.Uint64(v))
+}
+
+// decodeDate reads a day count from 2000-01-01, the Postgres epoch.
+func decodeDate(v []byte) db.Date {
+	switch days := decodeInt4(v); days {
+	case math.MaxInt32:
+		return db.Date{Date: pgtype.Date{InfinityModifier: pgtype.Infinity, Valid: true}}
+	case math.MinInt32:
+		return db.Date{Date: pgtype.Date{InfinityModifier: pgtype.NegativeInfinity, Valid: true}}
+	default:
+		return db.Date{Date: pgtype.Date{Time: time.Date(2000, 1, int(1+days), 0, 0, 0, 0, time.UTC), Valid: true}}
+	}
+}
+
+func appendInt4(b, v []byte) []byte {
+	return strconv.AppendInt(b, int64(decodeInt4(v)), 10)
+}
+
+// appendFloat formats f the way encoding/json and sonic do: the shortest
+// representation, switching to an exponent outside [1e-6, 1e21).
+func appendFloat(b []byte, f float64, bits int) ([]byte, error) {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return b, fmt.Errorf("unsupported value %v", f)
+	}
+
+	abs := math.Abs(f)
+	format := byte('f')
+	if abs != 0 && (bits == 64 && (abs < 1e-6 || abs >= 1e21) || bits == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21)) {
+		format = 'e'
+	}
+
+	b = strconv.AppendFloat(b, f, format, -1, bits)
+	if format == 'e' {
+		// Clean up e-09 to e-9.
+		if n := len(b); n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+			b[n-2] = b[n-1]
+			b = b[:n-1]
+		}
+	}
+
+	return b, nil
+}
+
+// escapes holds the escape sonic writes for each control character. Natively
+// it writes \u0008 and \u000c for \b and \f, but its encoding/json fallback,
+// used on the Go versions it has no native encoder for, writes \b and \f, so
+// the table is filled in from sonic itself.
+var escapes = func() (escapes [0x20][]byte) {
+	for c := range escapes {
+		quoted, err := sonic.Marshal(string(rune(c)))
+		if err != nil {
+			panic(err)
+		}
+		escapes[c] = quoted[1 : len(quoted)-1]
+	}
+	return escapes
+}()
+
+// appendString quotes s as a JSON string, escaping only what JSON requires,
+// like the sonic encoder the other backends go through.
+func appendString(b, s []byte) []byte {
+	b = append(b, '"')
+
+	start := 0
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c >= utf8.RuneSelf || c >= 0x20 && c != '"' && c != '\\' {
+			i++
+			continue
+		}
+
+		b = append(b, s[start:i]...)
+		if c == '"' || c == '\\' {
+			b = append(b, '\\', c)
+		} else {
+			b = append(b, escapes[c]...)
+		}
+		i++
+		start = i
+	}
+
+	b = append(b, s[start:]...)
+	return append(b, '"')
+}
+
+// values reads the binary columns of a row in order, for the Store methods
+// that return typed rows. Go evaluates the calls in a composite literal left
+// to right, so a literal listing the fields in column order reads them right.
+// SQL NULL reads as the zero value of a non-pointer field.
+type values struct {
+	v [][]byte
+	i int
+}
+
+func (r *values) next() []byte {
+	v := r.v[r.i]
+	r.i++
+	return v
+}
+
+func (r *values) int4() int32 {
+	if v := r.next(); v != nil {
+		return decodeInt4(v)
+	}
+	return 0
+}
+
+func (r *values) int4Ptr() *int32 {
+	if v := r.next(); v != nil {
+		i := decodeInt4(v)
+		return &i
+	}
+	return nil
+}
+
+func (r *values) float4() float32 {
+	if v := r.next(); v != nil {
+		return decodeFloat4(v)
+	}
+	return 0
+}
+
+func (r *values) float8() float64 {
+	if v := r.next(); v != nil {
+		return decodeFloat8(v)
+	}
+	return 0
+}
+
+func (r *values) text() string {
+	return string(r.next())
+}
+
+func (r *values) textPtr() *string {
+	if v := r.next(); v != nil {
+		s := string(v)
+		return &s
+	}
+	return nil
+}
+
+func (r *values) date() db.Date {
+	if v := r.next(); v != nil {
+		return decodeDate(v)
+	}
+	return db.Date{}
+}
+
+// json copies the value out, since pgx reuses the row buffer.
+func (r *values) json() db.RawJSON {
+	if v := r.next(); v != nil {
+		return append(db.RawJSON(nil), v...)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"log"
+	"perf-drizzle/go/db"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// requestCounter counts the requests the server has started and finished, so
+// shutdown can tell the ones it drained from the ones it cut off.
+type requestCounter struct {
+	started  atomic.Int64
+	finished atomic.Int64
+}
+
+func (rc *requestCounter) handler(c fiber.Ctx) error {
+	rc.started.Add(1)
+	defer rc.finished.Add(1)
+
+	return c.Next()
+}
+
+// shutdown turns /readyz to 503 and keeps serving for drainDelay, so load
+// balancers stop routing here while connections are still accepted. It then
+// stops accepting connections, gives the requests in flight until timeout to
+// finish, and only then closes the store, so none of them fail on a closed
+// pool.
+func shutdown(app *fiber.App, store db.Store, requests *requestCounter, ready *readiness, drainDelay, timeout time.Duration) {
+	ready.stop()
+	if drainDelay > 0 {
+		log.Printf("not ready, serving for another %s before draining", drainDelay)
+		time.Sleep(drainDelay)
+	}
+
+	finished := requests.finished.Load()
+	log.Printf("shutting down with %d requests in flight", requests.started.Load()-finished)
+
+	start := time.Now()
+	if err := app.ShutdownWithTimeout(timeout); err != nil {
+		log.Printf("drain: %v", err)
+	}
+
+	drained := requests.finished.Load()
+	running := requests.started.Load() - drained
+	log.Printf("drained in %s: %d requests completed, %d still running at timeout", time.Since(start).Round(time.Millisecond), drained-finished, running)
+
+	if statter, ok := store.(db.PoolStatter); ok {
+		stat := statter.Stat()
+		log.Printf("pool: %d connections still acquired, %d acquires in total, %d canceled", stat.AcquiredConns(), stat.AcquireCount(), stat.CanceledAcquireCount())
+	}
+
+	// Closing the store waits for the queries under way, so the requests
+	// still running only count as aborted once it returns.
+	store.Close()
+
+	if running > 0 {
+		late := requests.finished.Load() - drained
+		log.Printf("store closed: %d of those requests finished after the timeout, %d aborted", late, running-late)
+	}
+}
@@ -0,0 +1,65 @@
+package sqlitedb
+
+// The queries of go/db/queries.sql rewritten for the schema of
+// src/sqlite/drizzle, where dates are timestamp_ms integers. Correlated
+// subqueries building json_object stand in for the lateral row_to_json joins,
+// and FTS5 tables over the searched columns stand in for to_tsvector.
+
+const (
+	customerColumns = `c.id, c.company_name, c.contact_name, c.contact_title, c.address, c.city, c.postal_code, c.region, c.country, c.phone, c.fax`
+
+	employeeColumns = `e.id, e.last_name, e.first_name, e.title, e.title_of_courtesy, e.birth_date, e.hire_date, e.address, e.city, e.postal_code, e.country, e.home_phone, e.extension, e.notes, e.recipient_id`
+
+	supplierColumns = `s.id, s.company_name, s.contact_name, s.contact_title, s.address, s.city, s.region, s.postal_code, s.country, s.phone`
+
+	productColumns = `p.id, p.name, p.qt_per_unit, p.unit_price, p.units_in_stock, p.units_on_order, p.reorder_level, p.discontinued, p.supplier_id`
+
+	orderTotalsColumns = `o.id, o.shipped_date, o.ship_name, o.ship_city, o.ship_country, count(d.product_id), sum(d.quantity), sum(d.quantity * d.unit_price)`
+)
+
+// iso formats a timestamp_ms column the way Date.prototype.toJSON does, for
+// the dates inside the json relations.
+func iso(column string) string {
+	return `strftime('%Y-%m-%dT%H:%M:%fZ', ` + column + ` / 1000.0, 'unixepoch')`
+}
+
+// ftsTables index the searched columns. They are external content tables, so
+// they only hold the index, rebuilt from the base tables on every start.
+var ftsTables = []string{
+	`create virtual table if not exists customers_fts using fts5(company_name, content='customers', content_rowid='id', tokenize='porter unicode61')`,
+	`insert into customers_fts(customers_fts) values ('rebuild')`,
+	`create virtual table if not exists products_fts using fts5(name, content='products', content_rowid='id', tokenize='porter unicode61')`,
+	`insert into products_fts(products_fts) values ('rebuild')`,
+}
+
+const customers = `select ` + customerColumns + ` from customers c order by c.id limit ? offset ?`
+
+const customerById = `select ` + customerColumns + ` from customers c where c.id = ?`
+
+const searchCustomer = `select ` + customerColumns + ` from customers_fts f join customers c on c.id = f.rowid where customers_fts match ? order by f.rank, c.id limit ? offset ?`
+
+const searchCustomerHeadline = `select ` + customerColumns + `, highlight(customers_fts, 0, '<b>', '</b>') from customers_fts f join customers c on c.id = f.rowid where customers_fts match ? order by f.rank, c.id limit ? offset ?`
+
+const employees = `select ` + employeeColumns + ` from employees e order by e.id limit ? offset ?`
+
+var employeeWithRecipient = `select ` + employeeColumns + `, (select json_object('id', r.id, 'lastName', r.last_name, 'firstName', r.first_name, 'title', r.title, 'titleOfCourtesy', r.title_of_courtesy, 'birthDate', ` + iso("r.birth_date") + `, 'hireDate', ` + iso("r.hire_date") + `, 'address', r.address, 'city', r.city, 'postalCode', r.postal_code, 'country', r.country, 'homePhone', r.home_phone, 'extension', r.extension, 'notes', r.notes, 'recipientId', r.recipient_id) from employees r where r.id = e.recipient_id) from employees e where e.id = ?`
+
+const suppliers = `select ` + supplierColumns + ` from suppliers s order by s.id limit ? offset ?`
+
+const supplierById = `select ` + supplierColumns + ` from suppliers s where s.id = ?`
+
+const products = `select ` + productColumns + ` from products p order by p.id limit ? offset ?`
+
+const productWithSupplier = `select ` + productColumns + `, (select json_object('id', s.id, 'companyName', s.company_name, 'contactName', s.contact_name, 'contactTitle', s.contact_title, 'address', s.address, 'city', s.city, 'region', s.region, 'postalCode', s.postal_code, 'country', s.country, 'phone', s.phone) from suppliers s where s.id = p.supplier_id) from products p where p.id = ?`
+
+const searchProduct = `select ` + productColumns + ` from products_fts f join products p on p.id = f.rowid where products_fts match ? order by f.rank, p.id limit ? offset ?`
+
+const searchProductHeadline = `select ` + productColumns + `, highlight(products_fts, 0, '<b>', '</b>') from products_fts f join products p on p.id = f.rowid where products_fts match ? order by f.rank, p.id limit ? offset ?`
+
+const ordersWithDetails = `select ` + orderTotalsColumns + ` from orders o left join order_details d on d.order_id = o.id group by o.id order by o.id limit ? offset ?`
+
+const orderWithDetails = `select ` + orderTotalsColumns + ` from orders o left join order_details d on d.order_id = o.id where o.id = ? group by o.id order by o.id`
+
+// json() keeps the product object from being quoted as a string, since the
+// JSON subtype of json_object does not survive a subquery.
+const orderWithDetailsAndProducts = `select o.id, o.order_date, o.required_date, o.shipped_date, o.ship_via, o.freight, o.ship_name, o.ship_city, o.ship_region, o.ship_postal_code, o.ship_country, o.customer_id, o.employee_id, (select json_group_array(json_object('unitPrice', d.unit_price, 'quantity', d.quantity, 'discount', d.discount, 'orderId', d.order_id, 'productId', d.product_id, 'product', json((select json_object('id', p.id, 'name', p.name, 'quantityPerUnit', p.qt_per_unit, 'unitPrice', p.unit_price, 'unitsInStock', p.units_in_stock, 'unitsOnOrder', p.units_on_order, 'reorderLevel', p.reorder_level, 'discontinued', p.discontinued, 'supplierId', p.supplier_id) from products p where p.id = d.product_id)))) from order_details d where d.order_id = o.id) from orders o where o.id = ?`
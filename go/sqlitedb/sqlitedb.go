@@ -0,0 +1,279 @@
+// Package sqlitedb implements db.Store over the SQLite file seeded by
+// src/sqlite/seed.ts, through the pure Go modernc.org/sqlite driver. The file
+// is switched to WAL and given its FTS5 indexes over one write connection at
+// startup, and the routes are served from a separate pool of read-only
+// connections.
+package sqlitedb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"perf-drizzle/go/db"
+	"strings"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+// DefaultPath is where src/sqlite/seed.ts writes the database.
+const DefaultPath = "src/sqlite/northwind.db"
+
+type Store struct {
+	db *sql.DB
+}
+
+// dsn opens path without creating it, with the given pragmas set on every
+// connection.
+func dsn(path string, pragmas ...string) string {
+	q := url.Values{"mode": {"rw"}}
+	for _, p := range pragmas {
+		q.Add("_pragma", p)
+	}
+
+	return "file:" + path + "?" + q.Encode()
+}
+
+func New(path string, readConns int) (*Store, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	if err := prepare(ctx, path); err != nil {
+		return nil, err
+	}
+
+	sqlDB, err := sql.Open("sqlite", dsn(path, "busy_timeout(5000)", "query_only(true)"))
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDB.SetMaxOpenConns(readConns)
+	sqlDB.SetMaxIdleConns(readConns)
+
+	if err = sqlDB.PingContext(ctx); err != nil {
+		sqlDB.Close()
+
+		return nil, fmt.Errorf("unable to open %s: %w", path, err)
+	}
+
+	return &Store{db: sqlDB}, nil
+}
+
+// prepare switches the file to WAL, which persists, so readers never block
+// each other, and rebuilds the search indexes.
+func prepare(ctx context.Context, path string) error {
+	w, err := sql.Open("sqlite", dsn(path, "busy_timeout(5000)", "journal_mode(WAL)"))
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+
+	w.SetMaxOpenConns(1)
+
+	for _, stmt := range ftsTables {
+		if _, err := w.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("unable to prepare %s: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Store) Ping(ctx context.Context) error {
+	return s.db.PingContext(ctx)
+}
+
+func (s *Store) Close() {
+	s.db.Close()
+}
+
+// millis scans a timestamp_ms column into a db.Date.
+type millis struct {
+	d *db.Date
+}
+
+func (m millis) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		*m.d = db.Date{}
+	case int64:
+		t := time.UnixMilli(src).UTC()
+		*m.d = db.DateOf(&t)
+	default:
+		return fmt.Errorf("cannot scan %T into a timestamp_ms date", src)
+	}
+
+	return nil
+}
+
+// match turns a search into an FTS5 query. Prefix mode matches the words as
+// prefixes, "word"* AND "other"*, joined by the search's operator. The other
+// modes match whole words, which the porter tokenizer stems like the english
+// configuration does, and phrase mode matches them in order.
+func match(s db.Search) string {
+	prefix := s.Mode == db.SearchPrefix
+
+	words := make([]string, len(s.Words))
+	for i, w := range s.Words {
+		words[i] = `"` + w + `"`
+		if prefix {
+			words[i] += "*"
+		}
+	}
+
+	switch {
+	case prefix && s.Op == db.SearchOr:
+		return strings.Join(words, " OR ")
+	case s.Mode == db.SearchPhrase:
+		return strings.Join(words, " + ")
+	default:
+		return strings.Join(words, " AND ")
+	}
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func list[T any](ctx context.Context, s *Store, scan func(r scanner, i *T) error, query string, args ...any) ([]T, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := []T{}
+	for rows.Next() {
+		var i T
+		if err := scan(rows, &i); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func one[T any](ctx context.Context, s *Store, scan func(r scanner, i *T) error, query string, args ...any) (T, error) {
+	var i T
+	err := scan(s.db.QueryRowContext(ctx, query, args...), &i)
+	return i, err
+}
+
+func scanCustomer(r scanner, i *db.Customer) error {
+	return r.Scan(&i.ID, &i.CompanyName, &i.ContactName, &i.ContactTitle, &i.Address, &i.City, &i.PostalCode, &i.Region, &i.Country, &i.Phone, &i.Fax)
+}
+
+func scanCustomerHeadline(r scanner, i *db.SearchCustomerHeadlineRow) error {
+	return r.Scan(&i.ID, &i.CompanyName, &i.ContactName, &i.ContactTitle, &i.Address, &i.City, &i.PostalCode, &i.Region, &i.Country, &i.Phone, &i.Fax, &i.Headline)
+}
+
+func scanEmployee(r scanner, i *db.EmployeesRow) error {
+	return r.Scan(&i.ID, &i.LastName, &i.FirstName, &i.Title, &i.TitleOfCourtesy, millis{&i.BirthDate}, millis{&i.HireDate}, &i.Address, &i.City, &i.PostalCode, &i.Country, &i.HomePhone, &i.Extension, &i.Notes, &i.RecipientId)
+}
+
+func scanEmployeeWithRecipient(r scanner, i *db.EmployeeWithRecipientRow) error {
+	return r.Scan(&i.ID, &i.LastName, &i.FirstName, &i.Title, &i.TitleOfCourtesy, millis{&i.BirthDate}, millis{&i.HireDate}, &i.Address, &i.City, &i.PostalCode, &i.Country, &i.HomePhone, &i.Extension, &i.Notes, &i.RecipientId, &i.Recipient)
+}
+
+func scanSupplier(r scanner, i *db.Supplier) error {
+	return r.Scan(&i.ID, &i.CompanyName, &i.ContactName, &i.ContactTitle, &i.Address, &i.City, &i.Region, &i.PostalCode, &i.Country, &i.Phone)
+}
+
+func scanProduct(r scanner, i *db.ProductsRow) error {
+	return r.Scan(&i.ID, &i.Name, &i.QuantityPerUnit, &i.UnitPrice, &i.UnitsInStock, &i.UnitsOnOrder, &i.ReorderLevel, &i.Discontinued, &i.SupplierId)
+}
+
+func scanProductWithSupplier(r scanner, i *db.ProductWithSupplierRow) error {
+	return r.Scan(&i.ID, &i.Name, &i.QuantityPerUnit, &i.UnitPrice, &i.UnitsInStock, &i.UnitsOnOrder, &i.ReorderLevel, &i.Discontinued, &i.SupplierId, &i.Supplier)
+}
+
+func scanSearchProduct(r scanner, i *db.SearchProductRow) error {
+	return scanProduct(r, (*db.ProductsRow)(i))
+}
+
+func scanProductHeadline(r scanner, i *db.SearchProductHeadlineRow) error {
+	return r.Scan(&i.ID, &i.Name, &i.QuantityPerUnit, &i.UnitPrice, &i.UnitsInStock, &i.UnitsOnOrder, &i.ReorderLevel, &i.Discontinued, &i.SupplierId, &i.Headline)
+}
+
+func scanOrderTotals(r scanner, i *db.OrdersWithDetailsRow) error {
+	return r.Scan(&i.ID, millis{&i.ShippedDate}, &i.ShipName, &i.ShipCity, &i.ShipCountry, &i.ProductsCount, &i.QuantitySum, &i.TotalPrice)
+}
+
+func scanOrderWithDetails(r scanner, i *db.OrderWithDetailsRow) error {
+	return scanOrderTotals(r, (*db.OrdersWithDetailsRow)(i))
+}
+
+func scanOrderWithDetailsAndProducts(r scanner, i *db.OrderWithDetailsAndProductsRow) error {
+	return r.Scan(&i.ID, millis{&i.OrderDate}, millis{&i.RequiredDate}, millis{&i.ShippedDate}, &i.ShipVia, &i.Freight, &i.ShipName, &i.ShipCity, &i.ShipRegion, &i.ShipPostalCode, &i.ShipCountry, &i.CustomerId, &i.EmployeeId, &i.Details)
+}
+
+func (s *Store) Customers(ctx context.Context, arg db.CustomersParams) ([]db.Customer, error) {
+	return list(ctx, s, scanCustomer, customers, arg.Limit, arg.Offset)
+}
+
+func (s *Store) CustomerById(ctx context.Context, id int32) (db.Customer, error) {
+	return one(ctx, s, scanCustomer, customerById, id)
+}
+
+func (s *Store) SearchCustomer(ctx context.Context, arg db.SearchParams) ([]db.Customer, error) {
+	return list(ctx, s, scanCustomer, searchCustomer, match(arg.Search), arg.Limit, arg.Offset)
+}
+
+func (s *Store) SearchCustomerHeadline(ctx context.Context, arg db.SearchParams) ([]db.SearchCustomerHeadlineRow, error) {
+	return list(ctx, s, scanCustomerHeadline, searchCustomerHeadline, match(arg.Search), arg.Limit, arg.Offset)
+}
+
+func (s *Store) Employees(ctx context.Context, arg db.EmployeesParams) ([]db.EmployeesRow, error) {
+	return list(ctx, s, scanEmployee, employees, arg.Limit, arg.Offset)
+}
+
+func (s *Store) EmployeeWithRecipient(ctx context.Context, id int32) ([]db.EmployeeWithRecipientRow, error) {
+	return list(ctx, s, scanEmployeeWithRecipient, employeeWithRecipient, id)
+}
+
+func (s *Store) Suppliers(ctx context.Context, arg db.SuppliersParams) ([]db.Supplier, error) {
+	return list(ctx, s, scanSupplier, suppliers, arg.Limit, arg.Offset)
+}
+
+func (s *Store) SupplierById(ctx context.Context, id int32) (db.Supplier, error) {
+	return one(ctx, s, scanSupplier, supplierById, id)
+}
+
+func (s *Store) Products(ctx context.Context, arg db.ProductsParams) ([]db.ProductsRow, error) {
+	return list(ctx, s, scanProduct, products, arg.Limit, arg.Offset)
+}
+
+func (s *Store) ProductWithSupplier(ctx context.Context, id int32) ([]db.ProductWithSupplierRow, error) {
+	return list(ctx, s, scanProductWithSupplier, productWithSupplier, id)
+}
+
+func (s *Store) SearchProduct(ctx context.Context, arg db.SearchParams) ([]db.SearchProductRow, error) {
+	return list(ctx, s, scanSearchProduct, searchProduct, match(arg.Search), arg.Limit, arg.Offset)
+}
+
+func (s *Store) SearchProductHeadline(ctx context.Context, arg db.SearchParams) ([]db.SearchProductHeadlineRow, error) {
+	return list(ctx, s, scanProductHeadline, searchProductHeadline, match(arg.Search), arg.Limit, arg.Offset)
+}
+
+func (s *Store) OrdersWithDetails(ctx context.Context, arg db.OrdersWithDetailsParams) ([]db.OrdersWithDetailsRow, error) {
+	return list(ctx, s, scanOrderTotals, ordersWithDetails, arg.Limit, arg.Offset)
+}
+
+func (s *Store) OrderWithDetails(ctx context.Context, id int32) ([]db.OrderWithDetailsRow, error) {
+	return list(ctx, s, scanOrderWithDetails, orderWithDetails, id)
+}
+
+func (s *Store) OrderWithDetailsAndProducts(ctx context.Context, id int32) ([]db.OrderWithDetailsAndProductsRow, error) {
+	return list(ctx, s, scanOrderWithDetailsAndProducts, orderWithDetailsAndProducts, id)
+}
+
+var (
+	_ db.Store       = (*Store)(nil)
+	_ db.Highlighter = (*Store)(nil)
+	_ db.Pinger      = (*Store)(nil)
+)
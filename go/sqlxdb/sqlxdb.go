@@ -0,0 +1,135 @@
+// Package sqlxdb implements db.Store with sqlx, scanning the lateral
+// row_to_json queries into the sqlc row structs by their JSON tags.
+package sqlxdb
+
+import (
+	"context"
+	"perf-drizzle/go/db"
+	"perf-drizzle/go/stdlibdb"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/jmoiron/sqlx"
+	"github.com/jmoiron/sqlx/reflectx"
+)
+
+type Store struct {
+	db *sqlx.DB
+}
+
+func New(databaseUrl string, pool stdlibdb.PoolConfig) (*Store, error) {
+	x, err := sqlx.Open("pgx", databaseUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	pool.Apply(x.DB)
+
+	// The queries alias every column to the JSON name of its field.
+	x.Mapper = reflectx.NewMapper("json")
+
+	return &Store{db: x}, nil
+}
+
+func (s *Store) Ping(ctx context.Context) error {
+	return s.db.PingContext(ctx)
+}
+
+func (s *Store) Close() {
+	s.db.Close()
+}
+
+func (s *Store) Customers(ctx context.Context, arg db.CustomersParams) ([]db.Customer, error) {
+	items := []db.Customer{}
+	err := s.db.SelectContext(ctx, &items, db.CustomersSQL, arg.Limit, arg.Offset)
+	return items, err
+}
+
+func (s *Store) CustomerById(ctx context.Context, id int32) (db.Customer, error) {
+	var i db.Customer
+	err := s.db.GetContext(ctx, &i, db.CustomerByIdSQL, id)
+	return i, err
+}
+
+func (s *Store) SearchCustomer(ctx context.Context, arg db.SearchParams) ([]db.Customer, error) {
+	items := []db.Customer{}
+	err := s.db.SelectContext(ctx, &items, db.SearchCustomerSQL, string(arg.Mode), arg.Query(), arg.Offset, arg.Limit)
+	return items, err
+}
+
+func (s *Store) SearchCustomerHeadline(ctx context.Context, arg db.SearchParams) ([]db.SearchCustomerHeadlineRow, error) {
+	items := []db.SearchCustomerHeadlineRow{}
+	err := s.db.SelectContext(ctx, &items, db.SearchCustomerHeadlineSQL, string(arg.Mode), arg.Query(), arg.Offset, arg.Limit)
+	return items, err
+}
+
+func (s *Store) Employees(ctx context.Context, arg db.EmployeesParams) ([]db.EmployeesRow, error) {
+	items := []db.EmployeesRow{}
+	err := s.db.SelectContext(ctx, &items, db.EmployeesSQL, arg.Limit, arg.Offset)
+	return items, err
+}
+
+func (s *Store) EmployeeWithRecipient(ctx context.Context, id int32) ([]db.EmployeeWithRecipientRow, error) {
+	items := []db.EmployeeWithRecipientRow{}
+	err := s.db.SelectContext(ctx, &items, db.EmployeeWithRecipientSQL, id)
+	return items, err
+}
+
+func (s *Store) Suppliers(ctx context.Context, arg db.SuppliersParams) ([]db.Supplier, error) {
+	items := []db.Supplier{}
+	err := s.db.SelectContext(ctx, &items, db.SuppliersSQL, arg.Limit, arg.Offset)
+	return items, err
+}
+
+func (s *Store) SupplierById(ctx context.Context, id int32) (db.Supplier, error) {
+	var i db.Supplier
+	err := s.db.GetContext(ctx, &i, db.SupplierByIdSQL, id)
+	return i, err
+}
+
+func (s *Store) Products(ctx context.Context, arg db.ProductsParams) ([]db.ProductsRow, error) {
+	items := []db.ProductsRow{}
+	err := s.db.SelectContext(ctx, &items, db.ProductsSQL, arg.Limit, arg.Offset)
+	return items, err
+}
+
+func (s *Store) ProductWithSupplier(ctx context.Context, id int32) ([]db.ProductWithSupplierRow, error) {
+	items := []db.ProductWithSupplierRow{}
+	err := s.db.SelectContext(ctx, &items, db.ProductWithSupplierSQL, id)
+	return items, err
+}
+
+func (s *Store) SearchProduct(ctx context.Context, arg db.SearchParams) ([]db.SearchProductRow, error) {
+	items := []db.SearchProductRow{}
+	err := s.db.SelectContext(ctx, &items, db.SearchProductSQL, string(arg.Mode), arg.Query(), arg.Offset, arg.Limit)
+	return items, err
+}
+
+func (s *Store) SearchProductHeadline(ctx context.Context, arg db.SearchParams) ([]db.SearchProductHeadlineRow, error) {
+	items := []db.SearchProductHeadlineRow{}
+	err := s.db.SelectContext(ctx, &items, db.SearchProductHeadlineSQL, string(arg.Mode), arg.Query(), arg.Offset, arg.Limit)
+	return items, err
+}
+
+func (s *Store) OrdersWithDetails(ctx context.Context, arg db.OrdersWithDetailsParams) ([]db.OrdersWithDetailsRow, error) {
+	items := []db.OrdersWithDetailsRow{}
+	err := s.db.SelectContext(ctx, &items, db.OrdersWithDetailsSQL, arg.Limit, arg.Offset)
+	return items, err
+}
+
+func (s *Store) OrderWithDetails(ctx context.Context, id int32) ([]db.OrderWithDetailsRow, error) {
+	items := []db.OrderWithDetailsRow{}
+	err := s.db.SelectContext(ctx, &items, db.OrderWithDetailsSQL, id)
+	return items, err
+}
+
+func (s *Store) OrderWithDetailsAndProducts(ctx context.Context, id int32) ([]db.OrderWithDetailsAndProductsRow, error) {
+	items := []db.OrderWithDetailsAndProductsRow{}
+	err := s.db.SelectContext(ctx, &items, db.OrderWithDetailsAndProductsSQL, id)
+	return items, err
+}
+
+var (
+	_ db.Store       = (*Store)(nil)
+	_ db.Highlighter = (*Store)(nil)
+	_ db.Pinger      = (*Store)(nil)
+)
@@ -0,0 +1,82 @@
+package stdlibdb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// conn adapts *sql.DB to db.DBTX, so the sqlc generated code and SQL run
+// unchanged through database/sql. Only what the generated code calls is
+// backed by the driver; the pgx specific parts of pgx.Rows return zero values.
+type conn struct {
+	db *sql.DB
+}
+
+func (c conn) Exec(ctx context.Context, query string, args ...interface{}) (pgconn.CommandTag, error) {
+	_, err := c.db.ExecContext(ctx, query, args...)
+	return pgconn.CommandTag{}, err
+}
+
+func (c conn) Query(ctx context.Context, query string, args ...interface{}) (pgx.Rows, error) {
+	rs, err := c.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return &rows{rows: rs}, nil
+}
+
+func (c conn) QueryRow(ctx context.Context, query string, args ...interface{}) pgx.Row {
+	return c.db.QueryRowContext(ctx, query, args...)
+}
+
+type rows struct {
+	rows *sql.Rows
+	err  error
+}
+
+func (r *rows) Close() {
+	if err := r.rows.Close(); err != nil && r.err == nil {
+		r.err = err
+	}
+}
+
+func (r *rows) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.rows.Err()
+}
+
+func (r *rows) Next() bool {
+	return r.rows.Next()
+}
+
+func (r *rows) Scan(dest ...any) error {
+	return r.rows.Scan(dest...)
+}
+
+func (r *rows) Values() ([]any, error) {
+	columns, err := r.rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]any, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+
+	if err := r.rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+func (r *rows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *rows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *rows) RawValues() [][]byte                          { return nil }
+func (r *rows) Conn() *pgx.Conn                              { return nil }
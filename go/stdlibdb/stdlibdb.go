@@ -0,0 +1,83 @@
+// Package stdlibdb runs the sqlc queries through database/sql instead of pgx's
+// native interface, to separate driver and pool overhead from ORM overhead.
+package stdlibdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"perf-drizzle/go/db"
+	"time"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	_ "github.com/lib/pq"
+)
+
+// database/sql driver names.
+const (
+	DriverPgx = "pgx"
+	DriverPq  = "postgres"
+)
+
+// PoolConfig holds the database/sql pool settings, which behave differently
+// from pgxpool's: idle connections above MaxIdleConns are closed on release.
+type PoolConfig struct {
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
+}
+
+// DefaultPoolConfig matches the connection count of db.DefaultPoolConfig.
+var DefaultPoolConfig = PoolConfig{
+	MaxOpenConns: 200,
+	MaxIdleConns: 200,
+}
+
+// Apply sets the pool of sqlDB, which every backend over database/sql shares
+// the settings of.
+func (p PoolConfig) Apply(sqlDB *sql.DB) {
+	sqlDB.SetMaxOpenConns(p.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(p.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(p.ConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(p.ConnMaxIdleTime)
+}
+
+type Store struct {
+	db.Searches
+	sqlDB *sql.DB
+}
+
+func New(driver, databaseUrl string, pool PoolConfig) (*Store, error) {
+	sqlDB, err := sql.Open(driver, databaseUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	pool.Apply(sqlDB)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err = sqlDB.PingContext(ctx); err != nil {
+		sqlDB.Close()
+
+		return nil, fmt.Errorf("unable to ping database: %w", err)
+	}
+
+	return &Store{Searches: db.Searches{Queries: db.New(conn{db: sqlDB})}, sqlDB: sqlDB}, nil
+}
+
+func (s *Store) Ping(ctx context.Context) error {
+	return s.sqlDB.PingContext(ctx)
+}
+
+func (s *Store) Close() {
+	s.sqlDB.Close()
+}
+
+var (
+	_ db.Store       = (*Store)(nil)
+	_ db.Highlighter = (*Store)(nil)
+	_ db.Pinger      = (*Store)(nil)
+)